@@ -33,6 +33,8 @@ tokens {
     INDENT, DEDENT // https://docs.python.org/3.8/reference/lexical_analysis.html#indentation
   , TYPE_COMMENT // not supported
   , FSTRING_START, FSTRING_MIDDLE, FSTRING_END // only for compatibility with the PythonLexerBase class
+  , MATCH, CASE // soft keywords (Python 3.10), only for the optional retagging by the PythonLexerBase class
+  , ENDMARKER // only for the optional end marker of the PythonLexerBase class (as in the tokenize module of CPython)
 }
 
 /*
@@ -266,7 +268,7 @@ fragment EXPONENT       : ('e' | 'E') ('+' | '-')? DIGIT_PART;
 fragment IMAG_NUMBER : (FLOAT_NUMBER | DIGIT_PART) ('j' | 'J');
 
 // https://docs.python.org/3.8/reference/lexical_analysis.html#physical-lines
-fragment OS_INDEPENDENT_NL : '\r'? '\n'; // Unix, Windows
+fragment OS_INDEPENDENT_NL : '\r'? '\n' | '\r'; // Unix, Windows, old Macintosh
 
 // https://github.com/RobEin/ANTLR4-parser-for-Python-3.8/tree/main/valid_chars_in_py_identifiers
 fragment ID_CONTINUE:
@@ -281,7 +281,7 @@ public abstract class PythonLexerBase : Lexer
     {
         // remove the \<newline> escape sequences from the string literal
         // https://docs.python.org/3.11/reference/lexical_analysis.html#string-and-bytes-literals
-        string line_joinFreeStringLiteral = Regex.Replace(this.curToken.Text, @"\\\r?\n", "");
+        string line_joinFreeStringLiteral = Regex.Replace(this.curToken.Text, @"\\(\r?\n|\r)", "");
         if (this.curToken.Text.Length == line_joinFreeStringLiteral.Length)
         {
             this.AddPendingToken(this.curToken);
@@ -0,0 +1,2342 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2021 Robert Einhorn
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+/*
+ *
+ * Project      : Python Indent/Dedent handler for ANTLR4 grammars
+ *
+ * Developed by : Robert Einhorn, robert.einhorn.hu@gmail.com
+ *
+ */
+
+package parser
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+const (
+	invalidLength    = -1
+	errTxt           = " ERROR: "
+	warningTxt       = " WARNING: "
+	defaultTabLength = 8 // the standard number of spaces to replace a tab to spaces
+)
+
+// FormFeedMode is the treatment of the form feeds in the indentation (see SetFormFeedMode)
+type FormFeedMode int
+
+const (
+	FormFeedReset  FormFeedMode = iota // the indentation length starts after the last form feed (as in CPython)
+	FormFeedIgnore                     // the form feeds are zero-width
+	FormFeedError                      // the form feeds are zero-width and they are reported as indentation errors
+)
+
+// DedentPositionMode is the position of the inserted DEDENT tokens (see SetDedentPositionMode)
+type DedentPositionMode int
+
+const (
+	DedentAtFollowing       DedentPositionMode = iota // at the start of the following token
+	DedentAtPreviousLineEnd                           // at the end of the last token of the default channel (before the NEWLINE)
+)
+
+// LexerErrorKind classifies the errors reported by the PythonLexerBase
+type LexerErrorKind int
+
+const (
+	LexerErrorTokenRecognition   LexerErrorKind = iota // unrecognized character
+	LexerErrorIndentation                              // indented first statement, inconsistent dedent, tab in no-tabs mode
+	LexerErrorMixedTabsAndSpaces                       // inconsistent use of tabs and spaces in indentation
+	LexerErrorFString                                  // single '}' or unterminated replacement field in an f-string
+	LexerErrorStyle                                    // a warning only: indentation not a multiple of the indent unit
+	LexerErrorBracket                                  // unmatched closing bracket
+	LexerErrorNumericLiteral                           // invalid underscore in a numeric literal
+)
+
+// LexerErrorCode identifies the errors reported by the PythonLexerBase
+type LexerErrorCode string
+
+const (
+	ErrTokenRecognition   LexerErrorCode = "token-recognition"
+	ErrUnexpectedIndent   LexerErrorCode = "unexpected-indent" // first statement indented
+	ErrMixedTabsSpaces    LexerErrorCode = "mixed-tabs-spaces"
+	ErrTabInIndentation   LexerErrorCode = "tab-in-indentation"
+	ErrInconsistentDedent LexerErrorCode = "inconsistent-dedent"
+	ErrFormFeedInIndent   LexerErrorCode = "formfeed-in-indentation"
+	ErrFStringSingleBrace LexerErrorCode = "fstring-single-brace"
+	ErrFStringExpression  LexerErrorCode = "fstring-expression" // missing '}' of a replacement field
+	ErrUnmatchedBracket   LexerErrorCode = "unmatched-closing-bracket"
+	ErrNumericUnderscore  LexerErrorCode = "numeric-underscore"
+	WarnIndentUnit        LexerErrorCode = "indent-unit"
+	WarnIndentText        LexerErrorCode = "inconsistent-indent-text" // same width, different whitespace (see SetStrictIndentConsistency)
+	WarnWhitespaceLine    LexerErrorCode = "trailing-whitespace"      // a line of only spaces and tabs (see SetReportTrailingWhitespace)
+)
+
+// Kind returns the kind of the error code
+func (c LexerErrorCode) Kind() LexerErrorKind {
+	switch c {
+	case ErrTokenRecognition:
+		return LexerErrorTokenRecognition
+	case ErrMixedTabsSpaces:
+		return LexerErrorMixedTabsAndSpaces
+	case ErrFStringSingleBrace, ErrFStringExpression:
+		return LexerErrorFString
+	case ErrUnmatchedBracket:
+		return LexerErrorBracket
+	case ErrNumericUnderscore:
+		return LexerErrorNumericLiteral
+	case WarnIndentUnit, WarnIndentText, WarnWhitespaceLine:
+		return LexerErrorStyle
+	default:
+		return LexerErrorIndentation
+	}
+}
+
+// the English messages of the error codes (see SetMessages)
+var defaultMessages = map[LexerErrorCode]string{
+	ErrTokenRecognition:   "token recognition error at: '%s'",
+	ErrUnexpectedIndent:   "first statement indented",
+	ErrMixedTabsSpaces:    "inconsistent use of tabs and spaces in indentation",
+	ErrTabInIndentation:   "tabs are not allowed in indentation",
+	ErrInconsistentDedent: "inconsistent dedent",
+	ErrFormFeedInIndent:   "form feed in indentation",
+	ErrFStringSingleBrace: "f-string: single '}' is not allowed",
+	ErrFStringExpression:  "f-string: expecting '}'",
+	ErrUnmatchedBracket:   "unmatched closing bracket",
+	ErrNumericUnderscore:  "invalid underscore in numeric literal",
+	WarnIndentUnit:        "indentation of %d columns is not a multiple of %d",
+	WarnIndentText:        "indentation whitespace differs from the block at the same width",
+	WarnWhitespaceLine:    "whitespace-only line",
+}
+
+// LexerError is an error reported by the PythonLexerBase
+type LexerError struct {
+	Line    int
+	Column  int
+	Message string
+	Kind    LexerErrorKind
+	Code    LexerErrorCode
+}
+
+// LexerException is passed to the error listeners as the RecognitionException of the lexer errors.
+// The Code field identifies the error.
+type LexerException struct {
+	LexerError
+	offendingToken antlr.Token
+	input          antlr.IntStream
+}
+
+func (e *LexerException) GetOffendingToken() antlr.Token  { return e.offendingToken }
+func (e *LexerException) GetMessage() string              { return e.Message }
+func (e *LexerException) GetInputStream() antlr.IntStream { return e.input }
+
+// WarningListener receives the warnings of the lexer (see AddWarningListener) like an antlr.ErrorListener the errors,
+// the msg has a " LEXER WARNING: " prefix.
+type WarningListener interface {
+	SyntaxWarning(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException)
+}
+
+// an indentation stack change made by a queued INDENT or DEDENT token
+type indentChange struct {
+	token        antlr.Token
+	indentLength int // the pushed or popped indentation length
+	isPush       bool
+}
+
+// a FIFO queue of tokens: the consumed tokens are dropped from the head and the backing array is compacted,
+// so its size is bounded by the number of the waiting tokens instead of the number of all emitted tokens
+type tokenQueue struct {
+	tokens []antlr.Token
+	head   int // the index of the first waiting token
+}
+
+func (q *tokenQueue) len() int {
+	return len(q.tokens) - q.head
+}
+
+func (q *tokenQueue) push(token antlr.Token) {
+	if q.head > 0 && q.head >= len(q.tokens)/2 { // move the waiting tokens to the beginning of the backing array
+		n := copy(q.tokens, q.tokens[q.head:])
+		for i := n; i < len(q.tokens); i++ { // the moved tokens must not stay reachable from their old place
+			q.tokens[i] = nil
+		}
+		q.tokens = q.tokens[:n]
+		q.head = 0
+	}
+	q.tokens = append(q.tokens, token)
+}
+
+func (q *tokenQueue) pop() antlr.Token {
+	token := q.tokens[q.head]
+	q.tokens[q.head] = nil // the consumed token must not be retained by the queue
+	q.head++
+	return token
+}
+
+func (q *tokenQueue) last() antlr.Token {
+	return q.tokens[len(q.tokens)-1]
+}
+
+var lineJoinRegexp = regexp.MustCompile(`\\(\r?\n|\r)`) // the \<newline> escape sequence
+
+var formatVerbRegexp = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]*)?[a-zA-Z%]`) // e.g. %s, %d, %5.2f, %%
+
+var encodingDeclarationRegexp = regexp.MustCompile(`^#.*?coding[:=][ \t]*([-\w.]+)`) // e.g. # -*- coding: latin-1 -*-
+
+var typeCommentRegexp = regexp.MustCompile(`^#[ \t]*type:[ \t]*(.*?)[ \t]*$`) // e.g. # type: List[int] or # type: ignore
+
+type PythonLexerBase struct {
+	*antlr.BaseLexer
+
+	// Optional hooks called when an INDENT or a DEDENT token is inserted,
+	// or when an inconsistent dedent changes the indentation stack (the error stands for the DEDENT token,
+	// a recovered dedent calls both hooks for the level of the actual indentation).
+	// The level and the width are the indentation level and length after the change,
+	// the at token is the token that follows the inserted token.
+	OnIndent func(level, width int, at antlr.Token)
+	OnDedent func(level, width int, at antlr.Token)
+	// Optional hook called for every queued token of all channels (including the inserted tokens), e.g. for tracing.
+	// It is called when the token is queued (or accepted for the direct return of an ordinary token), before NextToken returns it.
+	OnEmit func(token antlr.Token)
+
+	// A stack that keeps track of the indentation lengths
+	indentLengthStack []int
+	// The indentation whitespace of the levels of the indentLengthStack (only for SetStrictIndentConsistency)
+	indentTextStack []string
+	// A queue where tokens are waiting to be loaded into the token stream
+	pendingTokens tokenQueue
+	// The indentation stack changes of the pending INDENT and DEDENT tokens
+	pendingIndentChanges []indentChange
+
+	// last pending token types
+	previousPendingTokenType               int
+	lastPendingTokenTypeFromDefaultChannel int
+
+	// The amount of opened parentheses, square brackets or curly braces
+	opened int
+	// The amount of opened parentheses and square brackets in the current lexer mode
+	parenOrBracketOpenedStack []int
+	// The lexer mode stack and the current lexer mode (the BaseLexer does not expose them)
+	lexerModeStack []int
+	lexerMode      int
+
+	wasSpaceIndentation                  bool
+	wasTabIndentation                    bool
+	wasIndentationMixedWithSpacesAndTabs bool
+
+	declaredEncoding string // PEP 263 encoding declaration
+
+	// the position after the last token of the default channel (only for DedentAtPreviousLineEnd, line 0 means no token)
+	lastTokenEndIndex, lastTokenEndLine, lastTokenEndColumn int
+
+	isAtBlankLine  bool // the current NEWLINE token ends a blank line
+	needsMoreInput bool // the interactive input is incomplete
+	// the NEWLINE token that ends the last statement line is already queued at the end of the previous input chunk
+	// (see AppendInput)
+	isNEWLINEqueued bool
+	isFinished      bool // the token stream is ended by FinishStream
+
+	curToken antlr.Token // current (under processing) token
+	ffgToken antlr.Token // following (look ahead) token
+	// the tokens after the ffgToken that are already read from the BaseLexer (only for SetSoftKeywordMatch)
+	lookaheadTokens []antlr.Token
+	// the indentation levels of the match statements whose block may be still open
+	matchLevels []int
+
+	// the reported errors and warnings since the last Reset
+	errors   []LexerError
+	warnings []LexerError
+	// the deepest indentation level since the last Reset
+	maxIndentDepth int
+	// the indentation lengths of the first lines of the statements by line numbers (only for SetRecordLineIndents)
+	lineIndents map[int]int
+
+	// options (these are kept by Reset)
+	tabLength                  int // 0 means defaultTabLength
+	noTabsMode                 bool
+	indentChannel              int // the channel of the INDENT and DEDENT tokens
+	isWSVisible                bool
+	usePool                    bool // the inserted and copied tokens are *pooledToken
+	indentUnit                 int  // 0 means no check
+	recoverDedents             bool
+	keepBOM                    bool
+	formFeedMode               FormFeedMode
+	dedentPositionMode         DedentPositionMode
+	interactiveMode            bool
+	softKeywordMatch           bool
+	validateNumericUnderscores bool
+	emitTypeComments           bool
+	fStringExpressionMode      bool
+	recordLineIndents          bool
+	syntheticTokenText         func(ttype int) string // nil means the <SYMBOLIC_NAME> text
+	errorRecovery              bool
+	messages                   map[string]string // the translated messages by the error codes
+	strictIndentConsistency    bool
+	reportTrailingWhitespace   bool
+	allowLeadingIndent         bool
+	baseIndent                 int // 0 means no base indentation
+	emitEndmarker              bool
+	coalesceDedents            bool
+	mutex                      *sync.Mutex // nil means no locking (see SetSynchronized)
+	warningListeners           []WarningListener
+}
+
+// NewPythonTokenSource returns the generated PythonLexer (that embeds the PythonLexerBase) as a token source.
+// The PythonLexerBase methods are available by a type assertion to *PythonLexer.
+func NewPythonTokenSource(input antlr.CharStream) antlr.TokenSource {
+	return NewPythonLexer(input)
+}
+
+// NewPythonCommonTokenStream returns a token stream of the default channel for the PythonParser.
+func NewPythonCommonTokenStream(input antlr.CharStream) *antlr.CommonTokenStream {
+	return antlr.NewCommonTokenStream(NewPythonLexer(input), antlr.TokenDefaultChannel)
+}
+
+func (p *PythonLexerBase) init() {
+	p.indentLengthStack = nil
+	p.indentTextStack = nil
+	p.pendingTokens = tokenQueue{}
+	p.pendingIndentChanges = nil
+	p.previousPendingTokenType = 0
+	p.lastPendingTokenTypeFromDefaultChannel = 0
+	p.opened = 0
+	p.parenOrBracketOpenedStack = nil
+	p.lexerModeStack = nil
+	p.lexerMode = antlr.LexerDefaultMode
+	p.wasSpaceIndentation = false
+	p.wasTabIndentation = false
+	p.wasIndentationMixedWithSpacesAndTabs = false
+	p.declaredEncoding = ""
+	p.lastTokenEndIndex, p.lastTokenEndLine, p.lastTokenEndColumn = 0, 0, 0
+	p.isAtBlankLine = false
+	p.needsMoreInput = false
+	p.isNEWLINEqueued = false
+	p.isFinished = false
+	p.curToken = nil
+	p.ffgToken = nil
+	p.lookaheadTokens = nil
+	p.matchLevels = nil
+	p.errors = nil
+	p.warnings = nil
+	p.maxIndentDepth = 0
+	p.lineIndents = nil
+}
+
+// SetTabLength sets the number of columns of a tab stop used to compute the indentation lengths (default: 8).
+// The value is kept by Reset.
+func (p *PythonLexerBase) SetTabLength(tabLength int) error {
+	if tabLength <= 0 {
+		return fmt.Errorf("invalid tab length: %d (must be greater than 0)", tabLength)
+	}
+	p.tabLength = tabLength
+	return nil
+}
+
+// TabLength returns the number of columns of a tab stop.
+func (p *PythonLexerBase) TabLength() int {
+	if p.tabLength == 0 {
+		return defaultTabLength
+	}
+	return p.tabLength
+}
+
+// SetNoTabsMode rejects the tabs in the indentation of the lines (default: false).
+// Tabs after the first token of a line are not affected.
+func (p *PythonLexerBase) SetNoTabsMode(noTabsMode bool) {
+	p.noTabsMode = noTabsMode
+}
+
+// CurrentIndentLevel returns the number of the currently opened indentation levels (0 before the first token).
+// It can be called between the NextToken calls.
+func (p *PythonLexerBase) CurrentIndentLevel() int {
+	indentStack := p.IndentStackSnapshot()
+	if len(indentStack) == 0 {
+		return 0
+	}
+	return len(indentStack) - 1
+}
+
+// CurrentIndentWidth returns the indentation length of the current indentation level (0 before the first token).
+func (p *PythonLexerBase) CurrentIndentWidth() int {
+	indentStack := p.IndentStackSnapshot()
+	if len(indentStack) == 0 {
+		return 0
+	}
+	return indentStack[len(indentStack)-1]
+}
+
+// MaxIndentDepth returns the deepest indentation level (the number of the nested indented blocks) reached since the last Reset,
+// e.g. to find the over-nested functions. The inserted INDENT tokens are counted even if they are not returned by NextToken yet.
+func (p *PythonLexerBase) MaxIndentDepth() int {
+	return p.maxIndentDepth
+}
+
+// IndentStackSnapshot returns a copy of the indentation length stack as of the last token returned by NextToken.
+// It is empty before the first token, otherwise its element 0 is always the 0 indentation length of the top level.
+func (p *PythonLexerBase) IndentStackSnapshot() []int {
+	snapshot := make([]int, 0, len(p.indentLengthStack)+len(p.pendingIndentChanges))
+	snapshot = append(snapshot, p.indentLengthStack...)
+	for i := len(p.pendingIndentChanges) - 1; i >= 0; i-- { // undo the changes of the tokens that are still pending
+		if p.pendingIndentChanges[i].isPush {
+			snapshot = snapshot[:len(snapshot)-1]
+		} else {
+			snapshot = append(snapshot, p.pendingIndentChanges[i].indentLength)
+		}
+	}
+	return snapshot
+}
+
+// InImplicitLineJoin reports whether the lexer is inside parentheses, square brackets or curly braces,
+// where the NEWLINE tokens are hidden.
+func (p *PythonLexerBase) InImplicitLineJoin() bool {
+	return p.opened > 0
+}
+
+// OpenBracketDepth returns the number of the open parentheses, square brackets and curly braces.
+// It is updated when a bracket token is queued (before NextToken returns it).
+// The unmatched closing brackets are reported as errors and they are not counted.
+func (p *PythonLexerBase) OpenBracketDepth() int {
+	return p.opened
+}
+
+// DeclaredEncoding returns the encoding name of the PEP 263 encoding declaration
+// (e.g. # -*- coding: latin-1 -*- or # vim: set fileencoding=latin-1 :) in the first two lines,
+// or an empty string if there is no declaration. The input is not decoded by the lexer.
+func (p *PythonLexerBase) DeclaredEncoding() string {
+	return p.declaredEncoding
+}
+
+// Errors returns the errors reported since the last Reset.
+// The errors are dispatched to the error listeners as well.
+func (p *PythonLexerBase) Errors() []LexerError {
+	return append([]LexerError(nil), p.errors...)
+}
+
+// Warnings returns the warnings reported since the last Reset (see SetIndentUnit).
+// The warnings are dispatched to the warning listeners as well (see AddWarningListener).
+func (p *PythonLexerBase) Warnings() []LexerError {
+	return append([]LexerError(nil), p.warnings...)
+}
+
+// SetIndentUnit reports a warning for every indentation whose length is not a multiple of the unit (default: 0 means no check).
+// The INDENT tokens are inserted as usual.
+func (p *PythonLexerBase) SetIndentUnit(indentUnit int) error {
+	if indentUnit < 0 {
+		return fmt.Errorf("invalid indent unit: %d (must not be negative)", indentUnit)
+	}
+	p.indentUnit = indentUnit
+	return nil
+}
+
+// SetRecoverDedents recovers from an inconsistent dedent (default: false).
+// The line that does not match any enclosing indentation level continues the dedented block with its actual
+// indentation length. The error is reported to the error listeners but no ERRORTOKEN is inserted.
+func (p *PythonLexerBase) SetRecoverDedents(recoverDedents bool) {
+	p.recoverDedents = recoverDedents
+}
+
+// SetSkipBOM skips the byte order mark (U+FEFF) at the start of the input (default: true).
+func (p *PythonLexerBase) SetSkipBOM(skipBOM bool) {
+	p.keepBOM = !skipBOM
+}
+
+// SetFormFeedMode sets the treatment of the form feeds in the indentation (default: FormFeedReset).
+// The form feeds between the tokens are not affected.
+func (p *PythonLexerBase) SetFormFeedMode(formFeedMode FormFeedMode) {
+	p.formFeedMode = formFeedMode
+}
+
+// SetDedentPositionMode sets the position of the inserted DEDENT tokens (default: DedentAtFollowing).
+// The DEDENT tokens are zero-length tokens in both modes, their order in the token stream is not affected.
+func (p *PythonLexerBase) SetDedentPositionMode(dedentPositionMode DedentPositionMode) {
+	p.dedentPositionMode = dedentPositionMode
+}
+
+// SetInteractiveMode handles the input as one interactive entry like the Python REPL (default: false).
+// If the input ends in an open block, in brackets or after a compound statement header (e.g. "if x:\n"),
+// then no DEDENT and trailing NEWLINE tokens are inserted and NeedsMoreInput reports the incomplete input.
+// A blank line at the end of the input completes the open blocks.
+func (p *PythonLexerBase) SetInteractiveMode(interactiveMode bool) {
+	p.interactiveMode = interactiveMode
+}
+
+// NeedsMoreInput reports whether the interactive input is incomplete (see SetInteractiveMode).
+// It is valid after the EOF token.
+func (p *PythonLexerBase) NeedsMoreInput() bool {
+	return p.needsMoreInput
+}
+
+// LastDefaultChannelTokenType returns the type of the last queued token of the default channel (0 before the first token),
+// e.g. NEWLINE or DEDENT if the input would need no trailing NEWLINE token at this point (see insertTrailingTokens).
+// The visible WS tokens (see KeepWhitespaceVisible), the EOF and the ENDMARKER tokens are not counted,
+// so it is still valid after the EOF token.
+func (p *PythonLexerBase) LastDefaultChannelTokenType() int {
+	return p.lastPendingTokenTypeFromDefaultChannel
+}
+
+// AppendInput appends the text (e.g. the next line of a notebook cell) to the input after the EOF token was returned
+// by NextToken, then NextToken continues lexing the appended text as the continuation of the input.
+// Use it in the interactive mode (see SetInteractiveMode) to carry over the open blocks and brackets between the chunks:
+// an incomplete chunk ends without DEDENT and trailing NEWLINE tokens, and the NEWLINE tokens stay hidden until
+// a dangling open bracket is closed in a later chunk. The input is re-wrapped into a new antlr.InputStream.
+func (p *PythonLexerBase) AppendInput(text string) error {
+	defer p.lock()()
+	if p.previousPendingTokenType != antlr.TokenEOF || p.pendingTokens.len() > 0 {
+		return fmt.Errorf("the input can only be appended after the EOF token")
+	}
+	input := p.GetInputStream()
+	index, line, column := input.Index(), p.Interpreter.GetLine(), p.Interpreter.GetCharPositionInLine()
+	p.SetInputStream(antlr.NewInputStream(input.GetText(0, input.Size()-1) + text))
+	p.seekInput(index, line, column)
+	p.setLexerModes(p.lexerModeStack, p.lexerMode)
+
+	eofToken := p.ffgToken
+	p.ffgToken = nil
+	p.lookaheadTokens = nil // only the repeated EOF token
+	p.previousPendingTokenType = p.lastPendingTokenTypeFromDefaultChannel
+	p.needsMoreInput = false
+	switch {
+	case p.lastPendingTokenTypeFromDefaultChannel == 0: // no statement yet, the appended text is the start of the input
+		p.indentLengthStack = nil
+	case p.lastPendingTokenTypeFromDefaultChannel == PythonLexerNEWLINE && p.opened == 0:
+		// the indentation of the first appended line is handled after a zero-length placeholder of the queued NEWLINE token
+		p.isNEWLINEqueued = true
+		p.ffgToken = p.createToken(PythonLexerNEWLINE, antlr.TokenDefaultChannel, "", eofToken)
+	}
+	return nil
+}
+
+// FinishStream ends the token stream at the current position, e.g. for a tool that lexes only a prefix of a file.
+// It returns the queued tokens that are not returned by NextToken yet, followed by the trailing NEWLINE and DEDENT tokens
+// that close the open blocks like at the end of the input (the EOF token is not included).
+// The queued INDENT and DEDENT tokens of a line whose first token is not lexed yet are dropped with their indentation changes.
+// The rest of the input is not lexed: NextToken returns an EOF token at the first unlexed token from now on (until Reset).
+// A second call returns no tokens.
+func (p *PythonLexerBase) FinishStream() []antlr.Token {
+	defer p.lock()()
+	if p.isFinished {
+		return nil
+	}
+	p.isFinished = true
+	var tokens []antlr.Token
+	if p.previousPendingTokenType != antlr.TokenEOF {
+		tokens = p.dropIndentTokensOfUnlexedLine()
+		if p.ffgToken != nil {
+			p.ffgToken = p.createToken(antlr.TokenEOF, antlr.TokenDefaultChannel, "<EOF>", p.ffgToken)
+		} else { // no token has been lexed yet
+			p.ffgToken = p.BaseLexer.EmitEOF()
+		}
+		p.lookaheadTokens = nil
+		p.isNEWLINEqueued = false
+		if len(p.indentLengthStack) > 0 && p.lastPendingTokenTypeFromDefaultChannel > 0 {
+			p.insertTrailingTokens()
+		}
+		p.previousPendingTokenType = antlr.TokenEOF // NextToken returns the EOF token (ffgToken) from now on
+	}
+	for p.pendingTokens.len() > 0 {
+		if token := p.pendingTokens.pop(); token.GetTokenType() != antlr.TokenEOF {
+			tokens = append(tokens, token)
+		}
+	}
+	p.pendingIndentChanges = nil // all the changes are visible from now on
+	return tokens
+}
+
+// removes the queued tokens and returns the ones that are kept:
+// the INDENT and DEDENT tokens after the last queued statement token belong to the next line that is not lexed yet
+func (p *PythonLexerBase) dropIndentTokensOfUnlexedLine() []antlr.Token {
+	changedTokens := make(map[antlr.Token]bool, len(p.pendingIndentChanges))
+	for _, change := range p.pendingIndentChanges {
+		changedTokens[change.token] = true
+	}
+	var queued []antlr.Token
+	for p.pendingTokens.len() > 0 {
+		queued = append(queued, p.pendingTokens.pop())
+	}
+	isPartOfLineStart := func(token antlr.Token) bool {
+		return changedTokens[token] || token.GetChannel() != antlr.TokenDefaultChannel || token.GetTokenType() == PythonLexerWS
+	}
+	lineStart := len(queued)
+	for lineStart > 0 && isPartOfLineStart(queued[lineStart-1]) {
+		lineStart--
+	}
+	kept := append([]antlr.Token(nil), queued[:lineStart]...)
+	isDropped := make(map[antlr.Token]bool)
+	for _, token := range queued[lineStart:] {
+		if changedTokens[token] {
+			isDropped[token] = true
+		} else { // e.g. a comment line before the unlexed line
+			kept = append(kept, token)
+		}
+	}
+	if len(isDropped) == 0 {
+		return kept
+	}
+
+	i := len(p.pendingIndentChanges)
+	for ; i > 0 && isDropped[p.pendingIndentChanges[i-1].token]; i-- { // undo the changes of the dropped tokens
+		if change := p.pendingIndentChanges[i-1]; change.isPush {
+			p.indentLengthStack = p.indentLengthStack[:len(p.indentLengthStack)-1]
+		} else {
+			p.indentLengthStack = append(p.indentLengthStack, change.indentLength)
+		}
+	}
+	p.pendingIndentChanges = p.pendingIndentChanges[:i]
+	p.lastPendingTokenTypeFromDefaultChannel = PythonLexerNEWLINE // the INDENT and DEDENT tokens are inserted after a NEWLINE
+	for _, token := range kept[:lineStart] {
+		if token.GetChannel() == antlr.TokenDefaultChannel && token.GetTokenType() != PythonLexerWS {
+			p.lastPendingTokenTypeFromDefaultChannel = token.GetTokenType()
+		}
+	}
+	return kept
+}
+
+// SetSoftKeywordMatch retags the match and case soft keywords (Python 3.10) from NAME to MATCH and CASE (default: false).
+// The heuristic: the NAME is in a statement position (not in brackets), it is followed by at least one token
+// and its logical line ends with a colon; a case must be directly in the block of a retagged match.
+// Limits: the statements are not parsed, so an invalid line that looks like a match statement (e.g. "match = x:")
+// is retagged too, and a match statement after a semicolon is not retagged.
+// The PythonParser (Python 3.8) does not accept the MATCH and CASE tokens.
+func (p *PythonLexerBase) SetSoftKeywordMatch(softKeywordMatch bool) {
+	p.softKeywordMatch = softKeywordMatch
+}
+
+// SetValidateNumericUnderscores reports an error for the invalid underscores in the numeric literals
+// (e.g. 1__0, 1_, 0x__FF), no ERRORTOKEN is inserted (default: false).
+// A leading underscore (e.g. _1) makes an identifier, it is not checked.
+func (p *PythonLexerBase) SetValidateNumericUnderscores(validateNumericUnderscores bool) {
+	p.validateNumericUnderscores = validateNumericUnderscores
+}
+
+// SetEmitTypeComments retags the COMMENT tokens of the type comments (PEP 484, e.g. # type: int, # type: ignore)
+// to TYPE_COMMENT on the same channel, the text of the token is kept (default: false).
+// The type expression of the comment is returned by TypeCommentExpression.
+func (p *PythonLexerBase) SetEmitTypeComments(emitTypeComments bool) {
+	p.emitTypeComments = emitTypeComments
+}
+
+// TypeCommentExpression returns the text after the "type:" prefix of a TYPE_COMMENT token (e.g. "ignore", "List[int]"),
+// the second result is false if the token is not a type comment.
+func TypeCommentExpression(token antlr.Token) (string, bool) {
+	if token.GetTokenType() != PythonLexerTYPE_COMMENT {
+		return "", false
+	}
+	match := typeCommentRegexp.FindStringSubmatch(token.GetText())
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+// SetFStringExpressionMode splits the f-string literals into FSTRING_START, FSTRING_MIDDLE (literal chunks, conversions
+// and format specifications), LBRACE, expression, COLON, RBRACE and FSTRING_END tokens (default: false).
+// The expressions are tokenized by a new lexer, nested f-strings are split too. The PythonParser does not accept these tokens.
+func (p *PythonLexerBase) SetFStringExpressionMode(fStringExpressionMode bool) {
+	p.fStringExpressionMode = fStringExpressionMode
+}
+
+// SetRecordLineIndents records the indentation length of every line that starts a statement, see LineIndents (default: false).
+func (p *PythonLexerBase) SetRecordLineIndents(recordLineIndents bool) {
+	p.recordLineIndents = recordLineIndents
+}
+
+// LineIndents returns the recorded indentation lengths by line numbers since the last Reset (see SetRecordLineIndents).
+// The blank lines, the comment lines and the continuation lines of the statements are not included,
+// the lines with inconsistent tabs and spaces are not included either.
+func (p *PythonLexerBase) LineIndents() map[int]int {
+	lineIndents := make(map[int]int, len(p.lineIndents))
+	for line, indentationLength := range p.lineIndents {
+		lineIndents[line] = indentationLength
+	}
+	return lineIndents
+}
+
+// SetSyntheticTokenText sets the function that returns the text of the inserted INDENT, DEDENT and NEWLINE tokens
+// by their token types, e.g. an empty text for the tools that reprint the token stream (default: nil means "<INDENT>" etc.).
+// The inserted tokens with an error message text (e.g. the INDENT of an indented first statement) are not affected.
+func (p *PythonLexerBase) SetSyntheticTokenText(syntheticTokenText func(ttype int) string) {
+	p.syntheticTokenText = syntheticTokenText
+}
+
+// SetErrorRecovery reports the lexer errors without inserting ERRORTOKEN tokens (default: false),
+// e.g. for the tools that collect all the problems of the input.
+// The lexing continues with a best-effort indentation length: an indentation that mixes tabs and spaces
+// is computed by the tab stops, an inconsistent dedent is recovered like by SetRecoverDedents.
+func (p *PythonLexerBase) SetErrorRecovery(errorRecovery bool) {
+	p.errorRecovery = errorRecovery
+}
+
+// SetStrictIndentConsistency reports a warning (WarnIndentText) for a line whose indentation has the same length as
+// its block but a different whitespace text, e.g. a tab and 8 spaces (default: false). The INDENT/DEDENT tokens are not affected.
+// Only the whitespace after the last form feed is compared in the FormFeedReset mode.
+// The first line mixing tabs and spaces is checked too, before its error is reported (see ErrMixedTabsSpaces).
+func (p *PythonLexerBase) SetStrictIndentConsistency(strictIndentConsistency bool) {
+	p.strictIndentConsistency = strictIndentConsistency
+}
+
+// SetReportTrailingWhitespace reports a warning (WarnWhitespaceLine) for every line that contains only spaces and tabs
+// outside of brackets (default: false). These lines are blank lines: their indentation is ignored in both cases.
+// The lines of only form feeds are not reported.
+func (p *PythonLexerBase) SetReportTrailingWhitespace(reportTrailingWhitespace bool) {
+	p.reportTrailingWhitespace = reportTrailingWhitespace
+}
+
+// SetAllowLeadingIndent accepts an indented first statement, e.g. for a code fragment extracted from a larger file (default: false).
+// Its indentation opens a block by a normal INDENT token instead of the "first statement indented" error.
+func (p *PythonLexerBase) SetAllowLeadingIndent(allowLeadingIndent bool) {
+	p.allowLeadingIndent = allowLeadingIndent
+}
+
+// SetBaseIndent lexes the input as a code fragment whose statements are indented by the width, e.g. a function body
+// extracted from a class (default: 0). The indentation stack starts with the [0, width] lengths: the lines indented by
+// the width do not insert INDENT tokens, the relative indentation inside the fragment inserts INDENT and DEDENT tokens as usual,
+// and the trailing DEDENT tokens close the blocks down to the base indentation.
+// A line indented less than the base indentation closes the base level by a DEDENT token.
+func (p *PythonLexerBase) SetBaseIndent(width int) error {
+	if width < 0 {
+		return fmt.Errorf("invalid base indentation: %d (must not be negative)", width)
+	}
+	p.baseIndent = width
+	return nil
+}
+
+// SetEmitEndmarker inserts an ENDMARKER token at the position of the EOF token before it, after the trailing NEWLINE
+// and DEDENT tokens, like the tokenize module of CPython (default: false). The PythonParser does not accept the ENDMARKER token.
+// No ENDMARKER is inserted for an incomplete interactive input (see SetInteractiveMode).
+func (p *PythonLexerBase) SetEmitEndmarker(emitEndmarker bool) {
+	p.emitEndmarker = emitEndmarker
+}
+
+// SetCoalesceDedents inserts one DEDENT token for all the indentation levels closed by a line instead of one DEDENT token
+// per level (default: false). DedentCount returns the number of the closed levels, the text of a coalesced token is
+// e.g. "<DEDENT x3>" (by default). The OnDedent hook is still called for every closed level.
+// The PythonParser expects one DEDENT token per level.
+func (p *PythonLexerBase) SetCoalesceDedents(coalesceDedents bool) {
+	p.coalesceDedents = coalesceDedents
+}
+
+// SetSynchronized serializes the NextToken, AppendInput and FinishStream calls by a mutex, so one lexer can be driven
+// from more goroutines (default: false). Without it the lexer must not be used concurrently, e.g. the concurrent
+// NextToken calls corrupt the queue of the pending tokens. The other methods are not synchronized,
+// SetSynchronized itself must be called before the lexer is shared.
+func (p *PythonLexerBase) SetSynchronized(synchronized bool) {
+	if synchronized {
+		p.mutex = new(sync.Mutex)
+	} else {
+		p.mutex = nil
+	}
+}
+
+// locks the mutex of SetSynchronized, the returned function unlocks it
+func (p *PythonLexerBase) lock() func() {
+	if p.mutex == nil {
+		return func() {}
+	}
+	mutex := p.mutex
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// SetMessages sets the translated messages of the errors and warnings by their codes (e.g. "inconsistent-dedent"),
+// the missing codes keep the English messages. The messages of ErrTokenRecognition and WarnIndentUnit are
+// fmt formats like the English ones: "token recognition error at: '%s'" and "indentation of %d columns is not a multiple of %d".
+// An error is returned (and the messages are not changed) if a message has other fmt verbs than the English one.
+// The " LEXER ERROR: " and " LEXER WARNING: " prefixes are not translated.
+func (p *PythonLexerBase) SetMessages(messages map[string]string) error {
+	for code, message := range messages {
+		want := formatVerbRegexp.FindAllString(defaultMessages[LexerErrorCode(code)], -1)
+		if got := formatVerbRegexp.FindAllString(message, -1); !slices.Equal(got, want) {
+			return fmt.Errorf("invalid message of %s: the fmt verbs %v differ from %v", code, got, want)
+		}
+	}
+	p.messages = make(map[string]string, len(messages))
+	for code, message := range messages {
+		p.messages[code] = message
+	}
+	return nil
+}
+
+// AddWarningListener adds a listener of the warnings (e.g. an IndentationErrorListener),
+// the warnings are not dispatched to the error listeners. The listeners are kept by Reset.
+func (p *PythonLexerBase) AddWarningListener(listener WarningListener) {
+	p.warningListeners = append(p.warningListeners, listener)
+}
+
+// RemoveWarningListeners removes the listeners of the warnings.
+func (p *PythonLexerBase) RemoveWarningListeners() {
+	p.warningListeners = nil
+}
+
+// SetIndentChannel sets the channel of the inserted INDENT and DEDENT tokens (default: antlr.TokenDefaultChannel).
+// The parser only sees these tokens on the default channel.
+func (p *PythonLexerBase) SetIndentChannel(channel int) {
+	p.indentChannel = channel
+}
+
+// KeepWhitespaceVisible puts the WS tokens on the default channel instead of the hidden channel (default: false).
+// The INDENT/DEDENT handling is not affected.
+func (p *PythonLexerBase) KeepWhitespaceVisible(isVisible bool) {
+	p.isWSVisible = isVisible
+}
+
+// SetTokenPooling takes the tokens created by the lexer base (INDENT, DEDENT, NEWLINE, ERRORTOKEN, hidden copies etc.)
+// from a sync.Pool instead of allocating them (default: false).
+// These tokens can be given back by ReleaseTokens when the token stream and the parse tree are not used any more.
+func (p *PythonLexerBase) SetTokenPooling(usePool bool) {
+	p.usePool = usePool
+}
+
+// ReleaseTokens gives back the pooled tokens of the list to the pool, the other tokens are ignored.
+// The released tokens must not be used after the call.
+func ReleaseTokens(tokens []antlr.Token) {
+	for _, token := range tokens {
+		if t, ok := token.(*coalescedDedentToken); ok {
+			token = t.Token
+		}
+		if t, ok := token.(*pooledToken); ok {
+			*t = pooledToken{}
+			tokenPool.Put(t)
+		}
+	}
+}
+
+func (p *PythonLexerBase) NextToken() antlr.Token { // reading the input stream until a return EOF
+	defer p.lock()()
+	for p.pendingTokens.len() == 0 { // the queue is only refilled when it is empty to keep it short
+		if p.previousPendingTokenType == antlr.TokenEOF { // the EOF token is returned again like by the BaseLexer
+			return p.ffgToken
+		}
+		if token := p.checkNextToken(); token != nil { // an ordinary token is returned without queuing it
+			return token
+		}
+		// no token may be queued (e.g. for the NEWLINE placeholder of AppendInput)
+	}
+	token := p.pendingTokens.pop() // add the queued token to the token stream
+	for len(p.pendingIndentChanges) > 0 && p.pendingIndentChanges[0].token == token {
+		p.pendingIndentChanges = p.pendingIndentChanges[1:] // the change is visible from now on
+	}
+	return token
+}
+
+// returns the current token if it is returned directly by NextToken (it is not queued), otherwise nil
+func (p *PythonLexerBase) checkNextToken() antlr.Token {
+	if p.previousPendingTokenType != antlr.TokenEOF {
+		p.setCurrentAndFollowingTokens()
+		if len(p.indentLengthStack) == 0 { // We're at the first token
+			p.handleStartOfInput()
+		}
+		if p.softKeywordMatch && p.curToken.GetTokenType() == PythonLexerNAME {
+			p.handleSoftKeyword()
+		}
+
+		switch p.curToken.GetTokenType() {
+		case PythonLexerLPAR, PythonLexerLSQB, PythonLexerLBRACE:
+			p.opened++
+			p.addPendingToken(p.curToken)
+		case PythonLexerRPAR, PythonLexerRSQB, PythonLexerRBRACE:
+			if p.opened > 0 {
+				p.opened--
+			} else { // the following NEWLINE tokens must not be hidden because of an unmatched bracket
+				p.reportLexerError(ErrUnmatchedBracket)
+			}
+			p.addPendingToken(p.curToken)
+		case PythonLexerNEWLINE:
+			p.handleNEWLINEtoken()
+		case PythonLexerNUMBER:
+			p.addPendingToken(p.curToken)
+			if p.validateNumericUnderscores {
+				p.checkNumericUnderscores()
+			}
+		case PythonLexerSTRING:
+			p.handleSTRINGtoken()
+		case PythonLexerFSTRING_MIDDLE:
+			p.handleFSTRING_MIDDLE_token()
+		case PythonLexerERRORTOKEN:
+			p.reportLexerError(ErrTokenRecognition, p.curToken.GetText())
+			p.addPendingToken(p.curToken)
+		case antlr.TokenEOF:
+			p.handleEOFtoken()
+		default:
+			if p.pendingTokens.len() == 0 && !p.isFormatSpecificationColon() { // the fast path of the ordinary tokens
+				return p.acceptToken(p.curToken)
+			}
+			p.addPendingToken(p.curToken)
+		}
+		p.handleFORMAT_SPECIFICATION_MODE()
+	}
+	return nil
+}
+
+func (p *PythonLexerBase) setCurrentAndFollowingTokens() {
+	if p.ffgToken == nil {
+		p.skipBOM()
+		p.curToken = p.nextRawToken()
+	} else {
+		p.curToken = p.ffgToken
+	}
+
+	p.handleFStringLexerModes()
+
+	if p.curToken.GetTokenType() == antlr.TokenEOF {
+		p.ffgToken = p.curToken
+	} else {
+		p.ffgToken = p.nextRawToken()
+	}
+}
+
+// the tokens of the BaseLexer, the tokens read ahead by peekRawToken come first
+func (p *PythonLexerBase) nextRawToken() antlr.Token {
+	if len(p.lookaheadTokens) == 0 {
+		return p.BaseLexer.NextToken()
+	}
+	token := p.lookaheadTokens[0]
+	p.lookaheadTokens[0] = nil
+	p.lookaheadTokens = p.lookaheadTokens[1:]
+	return token
+}
+
+// returns the i-th token after the ffgToken (the EOF token is repeated)
+func (p *PythonLexerBase) peekRawToken(i int) antlr.Token {
+	for len(p.lookaheadTokens) <= i {
+		if n := len(p.lookaheadTokens); n > 0 && p.lookaheadTokens[n-1].GetTokenType() == antlr.TokenEOF {
+			return p.lookaheadTokens[n-1]
+		}
+		p.lookaheadTokens = append(p.lookaheadTokens, p.BaseLexer.NextToken())
+	}
+	return p.lookaheadTokens[i]
+}
+
+// skip the UTF-8 byte order mark at the start of the input (the column of the first token remains 0)
+func (p *PythonLexerBase) skipBOM() {
+	input := p.GetInputStream()
+	if !p.keepBOM && input.Index() == 0 && input.LA(1) == '\uFEFF' {
+		input.Consume()
+	}
+}
+
+// initialize the indentLengthStack
+// hide the leading NEWLINE token(s)
+// if exists, find the first statement (not NEWLINE, not EOF token) that comes from the default channel
+// insert a leading INDENT token if necessary
+func (p *PythonLexerBase) handleStartOfInput() {
+	// initialize the stack with a default 0 indentation length
+	p.indentLengthStack = append(p.indentLengthStack, 0) // this will never be popped off
+	if p.baseIndent > 0 {
+		p.pushIndentLength(p.baseIndent) // the level of the code fragment (see SetBaseIndent)
+	}
+	for p.curToken.GetTokenType() != antlr.TokenEOF {
+		if p.curToken.GetChannel() == antlr.TokenDefaultChannel {
+			if p.curToken.GetTokenType() == PythonLexerNEWLINE {
+				// all the NEWLINE tokens must be ignored before the first statement
+				p.hideAndAddPendingToken(p.curToken)
+			} else { // We're at the first statement
+				p.insertLeadingIndentToken()
+				return // continue the processing of the current token with checkNextToken()
+			}
+		} else {
+			if p.curToken.GetTokenType() == PythonLexerCOMMENT {
+				p.checkEncodingDeclaration()
+			} else if p.curToken.GetTokenType() == PythonLexerWS && p.ffgToken.GetTokenType() == PythonLexerNEWLINE {
+				p.checkWhitespaceLine()
+			}
+			p.addPendingToken(p.curToken) // it can be WS, EXPLICIT_LINE_JOINING or COMMENT token
+		}
+		p.setCurrentAndFollowingTokens()
+	} // continue the processing of the EOF token with checkNextToken()
+}
+
+// the current WS token is a whole blank line (see SetReportTrailingWhitespace)
+func (p *PythonLexerBase) checkWhitespaceLine() {
+	if p.reportTrailingWhitespace && strings.ContainsAny(p.curToken.GetText(), " \t") {
+		p.reportWarning(WarnWhitespaceLine)
+	}
+}
+
+// PEP 263: the encoding declaration is a comment line in the first or the second line (before the first statement)
+func (p *PythonLexerBase) checkEncodingDeclaration() {
+	if p.declaredEncoding == "" && p.curToken.GetLine() <= 2 {
+		if match := encodingDeclarationRegexp.FindStringSubmatch(p.curToken.GetText()); match != nil {
+			p.declaredEncoding = match[1]
+		}
+	}
+}
+
+func (p *PythonLexerBase) insertLeadingIndentToken() {
+	baseIndentLength := p.indentLengthStack[len(p.indentLengthStack)-1] // 0 or the base indentation (see SetBaseIndent)
+	indentationLength := 0
+	if p.previousPendingTokenType == PythonLexerWS {
+		prevToken := p.pendingTokens.last() // WS token
+		if p.formFeedMode == FormFeedError && strings.ContainsRune(prevToken.GetText(), '\f') {
+			p.reportLexerError(ErrFormFeedInIndent)
+			if !p.errorRecovery {
+				p.createAndAddPendingToken(PythonLexerERRORTOKEN, antlr.TokenDefaultChannel, errTxt+p.message(ErrFormFeedInIndent), p.curToken)
+			}
+		}
+		indentationLength = p.getIndentationLength(prevToken.GetText())
+	}
+	switch {
+	case indentationLength == baseIndentLength:
+	case indentationLength > baseIndentLength && p.allowLeadingIndent: // the first statement opens a block
+		p.createAndAddPendingToken(PythonLexerINDENT, p.indentChannel, "", p.curToken)
+		p.pushIndentLength(indentationLength)
+		p.addPendingIndentChange(indentationLength, true)
+		if p.OnIndent != nil {
+			p.OnIndent(len(p.indentLengthStack)-1, indentationLength, p.curToken)
+		}
+	case indentationLength != invalidLength && indentationLength < baseIndentLength: // the fragment starts left of its base
+		p.reportLexerError(ErrInconsistentDedent)
+		// the indentation of the first statement replaces the base level, so no unbalanced DEDENT token follows
+		p.indentLengthStack = p.indentLengthStack[:len(p.indentLengthStack)-1]
+		if indentationLength > 0 {
+			p.indentLengthStack = append(p.indentLengthStack, indentationLength)
+		}
+	default: // there is an "indentation" before the first statement
+		p.reportLexerError(ErrUnexpectedIndent)
+		// insert an INDENT token before the first statement to raise an 'unexpected indent' error later by the parser
+		p.createAndAddPendingToken(PythonLexerINDENT, p.indentChannel, errTxt+p.message(ErrUnexpectedIndent), p.curToken)
+	}
+	p.recordLineIndent(p.curToken.GetLine(), indentationLength)
+}
+
+// records the indentation length of the first line of a statement (see SetRecordLineIndents)
+func (p *PythonLexerBase) recordLineIndent(line int, indentationLength int) {
+	if p.recordLineIndents && indentationLength != invalidLength {
+		if p.lineIndents == nil {
+			p.lineIndents = make(map[int]int)
+		}
+		p.lineIndents[line] = indentationLength
+	}
+}
+
+// retag the match and case soft keywords (Python 3.10) by a heuristic (see SetSoftKeywordMatch)
+func (p *PythonLexerBase) handleSoftKeyword() {
+	var ttype int
+	switch p.curToken.GetText() {
+	case "match":
+		ttype = PythonLexerMATCH
+	case "case":
+		ttype = PythonLexerCASE
+	default:
+		return
+	}
+	switch p.lastPendingTokenTypeFromDefaultChannel {
+	case 0, PythonLexerNEWLINE, PythonLexerINDENT, PythonLexerDEDENT: // statement position
+	default:
+		return
+	}
+	if p.opened > 0 || !p.isSoftKeywordStatement() {
+		return
+	}
+
+	level := len(p.indentLengthStack)
+	for len(p.matchLevels) > 0 && p.matchLevels[len(p.matchLevels)-1] >= level { // the match blocks closed before this line
+		p.matchLevels = p.matchLevels[:len(p.matchLevels)-1]
+	}
+	if ttype == PythonLexerMATCH {
+		p.matchLevels = append(p.matchLevels, level)
+	} else if len(p.matchLevels) == 0 || p.matchLevels[len(p.matchLevels)-1] != level-1 {
+		return // a case statement must be directly in a match block
+	}
+	t := p.curToken
+	p.curToken = p.newToken(t, ttype, t.GetText(), t.GetChannel(), t.GetStart(), t.GetStop(), t.GetLine(), t.GetColumn())
+}
+
+// the soft keyword is followed by at least one token and its logical line ends with a colon
+func (p *PythonLexerBase) isSoftKeywordStatement() bool {
+	switch p.ffgToken.GetTokenType() {
+	case PythonLexerCOLON, PythonLexerNEWLINE, antlr.TokenEOF: // e.g. an annotated variable
+		return false
+	}
+	opened := 0
+	lastTokenType := 0
+	for i := -1; ; i++ {
+		token := p.ffgToken
+		if i >= 0 {
+			token = p.peekRawToken(i)
+		}
+		switch token.GetTokenType() {
+		case antlr.TokenEOF:
+			return lastTokenType == PythonLexerCOLON
+		case PythonLexerNEWLINE:
+			if opened == 0 {
+				return lastTokenType == PythonLexerCOLON
+			}
+		case PythonLexerLPAR, PythonLexerLSQB, PythonLexerLBRACE:
+			opened++
+		case PythonLexerRPAR, PythonLexerRSQB, PythonLexerRBRACE:
+			if opened > 0 {
+				opened--
+			}
+		}
+		if token.GetChannel() == antlr.TokenDefaultChannel && token.GetTokenType() != PythonLexerNEWLINE {
+			lastTokenType = token.GetTokenType()
+		}
+	}
+}
+
+// the grammar accepts only the valid underscores in the numeric literals (e.g. 1_000, 0x_FF),
+// an invalid underscore ends the NUMBER token and it starts a NAME token (e.g. 1__0, 1_, 1._5, 1e_5, 0x__FF)
+func (p *PythonLexerBase) checkNumericUnderscores() {
+	if p.ffgToken.GetTokenType() == PythonLexerNAME && p.ffgToken.GetStart() == p.curToken.GetStop()+1 &&
+		strings.ContainsRune(p.ffgToken.GetText(), '_') {
+		p.reportLexerError(ErrNumericUnderscore) // recoverable: the NAME token after the NUMBER token is a syntax error anyway
+	}
+}
+
+func (p *PythonLexerBase) handleNEWLINEtoken() {
+	if p.opened > 0 { // We're in an implicit line joining, ignore the current NEWLINE token
+		p.hideAndAddPendingToken(p.curToken)
+	} else {
+		nlToken := p.curToken // save the current NEWLINE token
+		isLookingAhead := p.ffgToken.GetTokenType() == PythonLexerWS
+		if isLookingAhead {
+			p.setCurrentAndFollowingTokens() // set the next two tokens
+		}
+
+		switch p.ffgToken.GetTokenType() {
+		case PythonLexerNEWLINE, // We're before a blank line
+			PythonLexerCOMMENT: // We're before a comment
+			p.addNEWLINEtoken(nlToken, true)
+			if isLookingAhead {
+				p.addPendingToken(p.curToken) // WS token
+				if p.ffgToken.GetTokenType() == PythonLexerNEWLINE {
+					p.checkWhitespaceLine()
+				}
+			}
+			p.isAtBlankLine = p.ffgToken.GetTokenType() == PythonLexerNEWLINE
+		default:
+			isIncompleteInput := p.isIncompleteInteractiveInput()
+			p.isAtBlankLine = false
+			p.addNEWLINEtoken(nlToken, p.isNEWLINEqueued)
+			p.isNEWLINEqueued = false
+			if isIncompleteInput { // the statement continues in the next input, no DEDENT tokens
+				p.needsMoreInput = true
+				if isLookingAhead {
+					p.addPendingToken(p.curToken) // WS token
+				}
+			} else if isLookingAhead { // We're on whitespace(s) followed by a statement
+				isIndentation := p.ffgToken.GetTokenType() != antlr.TokenEOF // the whitespace before the EOF is not an indentation
+				indentationLength := p.trailingIndentLength()                // the end of the input closes the blocks down to the base
+				if isIndentation {
+					indentationLength = p.getIndentationLength(p.curToken.GetText())
+					p.recordLineIndent(p.ffgToken.GetLine(), indentationLength)
+				}
+
+				p.addPendingToken(p.curToken) // WS token (kept for the restoring of the original input even if it is invalid)
+				if p.noTabsMode && isIndentation && strings.ContainsRune(p.curToken.GetText(), '\t') {
+					p.reportError(ErrTabInIndentation)
+				}
+				if indentationLength != invalidLength {
+					if p.formFeedMode == FormFeedError && isIndentation && strings.ContainsRune(p.curToken.GetText(), '\f') {
+						p.reportError(ErrFormFeedInIndent)
+					}
+					p.insertIndentOrDedentToken(indentationLength) // may insert INDENT token or DEDENT token(s)
+					p.checkIndentText(p.curToken.GetText(), indentationLength)
+				} else {
+					// the length by the tab stops: only the first line mixing tabs and spaces has an invalid length
+					indentationLength = p.getIndentationLength(p.curToken.GetText())
+					if p.errorRecovery { // continue with the indentation length computed by the tab stops
+						p.insertIndentOrDedentToken(indentationLength)
+					}
+					p.checkIndentText(p.curToken.GetText(), indentationLength) // the warning is reported before the error
+					p.reportError(ErrMixedTabsSpaces)
+				}
+			} else { // We're at a newline followed by a statement (there is no whitespace before the statement)
+				indentationLength := 0
+				if p.ffgToken.GetTokenType() != antlr.TokenEOF {
+					p.recordLineIndent(p.ffgToken.GetLine(), 0)
+				} else {
+					indentationLength = p.trailingIndentLength() // the end of the input closes the blocks down to the base
+				}
+				p.insertIndentOrDedentToken(indentationLength) // may insert DEDENT token(s)
+				p.checkIndentText("", indentationLength)
+			}
+		}
+	}
+}
+
+// the NEWLINE token is hidden if the visible NEWLINE of its line is queued at the end of the previous input chunk,
+// the zero-length placeholder of that NEWLINE is dropped (see AppendInput)
+func (p *PythonLexerBase) addNEWLINEtoken(nlToken antlr.Token, isHidden bool) {
+	switch {
+	case nlToken.GetStop() < nlToken.GetStart(): // the lexer does not produce zero-length NEWLINE tokens
+	case isHidden:
+		p.hideAndAddPendingToken(nlToken)
+	default:
+		p.addPendingToken(nlToken)
+	}
+}
+
+func (p *PythonLexerBase) insertIndentOrDedentToken(indentLength int) {
+	prevIndentLength := p.indentLengthStack[len(p.indentLengthStack)-1] // peek()
+	if indentLength > prevIndentLength {
+		p.createAndAddPendingToken(PythonLexerINDENT, p.indentChannel, "", p.ffgToken)
+		p.pushIndentLength(indentLength)
+		p.addPendingIndentChange(indentLength, true)
+		if p.indentUnit > 0 && indentLength%p.indentUnit != 0 {
+			p.reportWarning(WarnIndentUnit, indentLength, p.indentUnit)
+		}
+		if p.OnIndent != nil {
+			p.OnIndent(len(p.indentLengthStack)-1, indentLength, p.ffgToken)
+		}
+	} else {
+		// the popped indentation lengths of the coalesced DEDENT token (see SetCoalesceDedents)
+		var poppedIndentLengths []int
+		for indentLength < prevIndentLength { // more than 1 DEDENT token may be inserted to the token stream
+			p.indentLengthStack = p.indentLengthStack[:len(p.indentLengthStack)-1] // pop()
+			poppedIndentLength := prevIndentLength
+			prevIndentLength = p.indentLengthStack[len(p.indentLengthStack)-1] // peek()
+			if indentLength <= prevIndentLength {
+				if p.coalesceDedents {
+					poppedIndentLengths = append(poppedIndentLengths, poppedIndentLength)
+				} else {
+					p.addDedentToken(1)
+					p.addPendingIndentChange(poppedIndentLength, false)
+				}
+				if p.OnDedent != nil {
+					p.OnDedent(len(p.indentLengthStack)-1, prevIndentLength, p.ffgToken)
+				}
+				continue
+			}
+			p.addCoalescedDedentToken(poppedIndentLengths) // the DEDENT token comes before the error
+			poppedIndentLengths = nil
+			if p.recoverDedents || p.errorRecovery { // the line continues the popped block with its actual indentation length
+				p.reportLexerError(ErrInconsistentDedent)
+				p.pushIndentLength(indentLength)
+				p.addPendingIndentChange(poppedIndentLength, false)
+				p.addPendingIndentChange(indentLength, true)
+				if p.OnDedent != nil {
+					p.OnDedent(len(p.indentLengthStack)-2, prevIndentLength, p.ffgToken)
+				}
+				if p.OnIndent != nil {
+					p.OnIndent(len(p.indentLengthStack)-1, indentLength, p.ffgToken)
+				}
+			} else {
+				p.reportError(ErrInconsistentDedent)
+				p.addPendingIndentChange(poppedIndentLength, false) // the ERRORTOKEN stands for the DEDENT
+				if p.OnDedent != nil {
+					p.OnDedent(len(p.indentLengthStack)-1, prevIndentLength, p.ffgToken)
+				}
+			}
+		}
+		p.addCoalescedDedentToken(poppedIndentLengths)
+	}
+}
+
+// compares the indentation whitespace of a line to the whitespace of its block after the indentation stack was updated
+// (see SetStrictIndentConsistency), the text of a new block is saved
+func (p *PythonLexerBase) checkIndentText(textWS string, indentLength int) {
+	if !p.strictIndentConsistency || p.ffgToken.GetTokenType() == antlr.TokenEOF {
+		return
+	}
+	textWS = strings.ReplaceAll(textWS, "\r", "") // a line ending is not part of the indentation
+	if p.formFeedMode == FormFeedReset {
+		textWS = textWS[strings.LastIndexByte(textWS, '\f')+1:]
+	}
+	if len(p.indentTextStack) > len(p.indentLengthStack) { // the popped levels
+		p.indentTextStack = p.indentTextStack[:len(p.indentLengthStack)]
+	}
+	for len(p.indentTextStack) < len(p.indentLengthStack)-1 { // the levels whose text is unknown (e.g. after a form feed error)
+		p.indentTextStack = append(p.indentTextStack, "")
+	}
+	if len(p.indentTextStack) < len(p.indentLengthStack) { // a new block
+		p.indentTextStack = append(p.indentTextStack, textWS)
+	} else if p.indentLengthStack[len(p.indentLengthStack)-1] == indentLength && p.indentTextStack[len(p.indentTextStack)-1] != textWS {
+		p.reportWarning(WarnIndentText)
+	}
+}
+
+func (p *PythonLexerBase) pushIndentLength(indentLength int) {
+	p.indentLengthStack = append(p.indentLengthStack, indentLength)
+	if len(p.indentLengthStack)-1 > p.maxIndentDepth {
+		p.maxIndentDepth = len(p.indentLengthStack) - 1
+	}
+}
+
+// inserts one DEDENT token for the popped indentation lengths (see SetCoalesceDedents)
+func (p *PythonLexerBase) addCoalescedDedentToken(poppedIndentLengths []int) {
+	if len(poppedIndentLengths) == 0 {
+		return
+	}
+	p.addDedentToken(len(poppedIndentLengths))
+	for _, poppedIndentLength := range poppedIndentLengths {
+		p.addPendingIndentChange(poppedIndentLength, false)
+	}
+}
+
+// inserts a DEDENT token that closes the count levels
+func (p *PythonLexerBase) addDedentToken(count int) {
+	text := p.syntheticTokenTextOf(PythonLexerDEDENT)
+	if count > 1 && p.syntheticTokenText == nil {
+		text = fmt.Sprintf("<%s x%d>", p.SymbolicName(PythonLexerDEDENT), count)
+	}
+	var token antlr.Token
+	if p.dedentPositionMode == DedentAtPreviousLineEnd && p.lastTokenEndLine > 0 {
+		token = p.newToken(p.ffgToken, PythonLexerDEDENT, text,
+			p.indentChannel, p.lastTokenEndIndex, p.lastTokenEndIndex-1, p.lastTokenEndLine, p.lastTokenEndColumn)
+	} else {
+		token = p.createToken(PythonLexerDEDENT, p.indentChannel, text, p.ffgToken)
+	}
+	if count > 1 {
+		token = &coalescedDedentToken{token, count}
+	}
+	p.addPendingToken(token)
+}
+
+// a DEDENT token that closes more than one indentation level (see SetCoalesceDedents)
+type coalescedDedentToken struct {
+	antlr.Token
+	count int
+}
+
+// DedentCount returns the number of the indentation levels closed by a DEDENT token: more than 1 for a coalesced
+// DEDENT token (see SetCoalesceDedents), 1 for the other DEDENT tokens and 0 for the other token types.
+func DedentCount(token antlr.Token) int {
+	if t, ok := token.(*coalescedDedentToken); ok {
+		return t.count
+	}
+	if token.GetTokenType() == PythonLexerDEDENT {
+		return 1
+	}
+	return 0
+}
+
+// saves the position after the token for DedentAtPreviousLineEnd
+func (p *PythonLexerBase) setLastTokenEnd(token antlr.Token) {
+	// the text of the input is used because the \<newline> escape sequences are removed from the STRING tokens
+	text := []rune(p.GetInputStream().GetText(token.GetStart(), token.GetStop()))
+	line, column := token.GetLine(), token.GetColumn()
+	for _, c := range text {
+		if c == '\n' { // like the line counting of the lexer, a lone \r does not start a new line
+			line++
+			column = 0
+		} else {
+			column++
+		}
+	}
+	p.lastTokenEndIndex, p.lastTokenEndLine, p.lastTokenEndColumn = token.GetStop()+1, line, column
+}
+
+// the change belongs to the last pending token
+func (p *PythonLexerBase) addPendingIndentChange(indentLength int, isPush bool) {
+	lastToken := p.pendingTokens.last()
+	p.pendingIndentChanges = append(p.pendingIndentChanges, indentChange{lastToken, indentLength, isPush})
+}
+
+func (p *PythonLexerBase) handleSTRINGtoken() { // remove the \<newline> escape sequences from the string literal
+	if p.fStringExpressionMode {
+		if prefixLength, isFString := fStringPrefixLength(p.curToken.GetText()); isFString {
+			p.splitFStringLiteral(p.curToken, prefixLength) // the \<newline> escape sequences are kept in the FSTRING_MIDDLE tokens
+			return
+		}
+	}
+	line_joinFreeStringLiteral := lineJoinRegexp.ReplaceAllString(p.curToken.GetText(), "")
+	if len(p.curToken.GetText()) == len(line_joinFreeStringLiteral) {
+		p.addPendingToken(p.curToken)
+	} else {
+		originalSTRINGtoken := p.copyToken(p.curToken, p.curToken.GetChannel()) // backup the original token
+		p.curToken.SetText(line_joinFreeStringLiteral)
+		p.addPendingToken(p.curToken)                 // add the modified token with inline string literal
+		p.hideAndAddPendingToken(originalSTRINGtoken) // add the original token to the hidden channel
+		// this inserted hidden token allows to restore the original string literal with the \<newline> escape sequences
+	}
+}
+
+// returns the length of the string prefix and whether the prefix contains 'f' or 'F' (e.g. f, rF, Fr)
+func fStringPrefixLength(text string) (int, bool) {
+	prefixLength := strings.IndexAny(text, "'\"")
+	if prefixLength < 0 {
+		return 0, false
+	}
+	return prefixLength, strings.ContainsAny(text[:prefixLength], "fF")
+}
+
+// fStringSplitter splits an f-string literal token for SetFStringExpressionMode
+type fStringSplitter struct {
+	p       *PythonLexerBase
+	token   antlr.Token // the STRING token of the f-string literal
+	text    []rune      // the token indexes are character indexes
+	lines   []int       // the line of every character of the text
+	columns []int       // the column of every character of the text
+	isRaw   bool
+}
+
+func (p *PythonLexerBase) splitFStringLiteral(token antlr.Token, prefixLength int) {
+	s := &fStringSplitter{p: p, token: token, text: []rune(token.GetText())}
+	s.isRaw = strings.ContainsAny(string(s.text[:prefixLength]), "rR")
+	s.lines = make([]int, len(s.text)+1)
+	s.columns = make([]int, len(s.text)+1)
+	line, column := token.GetLine(), token.GetColumn()
+	for i, c := range s.text {
+		s.lines[i], s.columns[i] = line, column
+		if c == '\n' { // like the line counting of the lexer
+			line++
+			column = 0
+		} else {
+			column++
+		}
+	}
+	s.lines[len(s.text)], s.columns[len(s.text)] = line, column
+
+	quoteLength := 1
+	if len(s.text) >= prefixLength+6 && s.text[prefixLength+1] == s.text[prefixLength] && s.text[prefixLength+2] == s.text[prefixLength] {
+		quoteLength = 3 // the shortest long string is ''''''
+	}
+	end := len(s.text) - quoteLength
+	s.addToken(PythonLexerFSTRING_START, antlr.TokenDefaultChannel, 0, prefixLength+quoteLength)
+	s.splitLiteral(prefixLength+quoteLength, end, false)
+	s.addToken(PythonLexerFSTRING_END, antlr.TokenDefaultChannel, end, len(s.text))
+}
+
+// adds the text[start:stop] as a new token
+func (s *fStringSplitter) addToken(ttype int, channel int, start int, stop int) {
+	if start < stop {
+		s.p.addPendingToken(s.p.newToken(s.token, ttype, string(s.text[start:stop]),
+			channel, s.token.GetStart()+start, s.token.GetStart()+stop-1, s.lines[start], s.columns[start]))
+	}
+}
+
+// adds the FSTRING_MIDDLE tokens of the literal chunks and the replacement fields until the end of the literal
+// or until the '}' of a format specification, returns the index of the end
+func (s *fStringSplitter) splitLiteral(start int, end int, isFormatSpec bool) int {
+	chunkStart := start
+	for i := start; i < end; {
+		switch c := s.text[i]; {
+		case c == '\\' && !s.isRaw:
+			if i+2 < end && s.text[i+1] == 'N' && s.text[i+2] == '{' { // \N{name} escape sequence
+				for i < end && s.text[i] != '}' {
+					i++
+				}
+				i++
+			} else if i+1 < end && s.text[i+1] != '{' && s.text[i+1] != '}' {
+				i += 2
+			} else {
+				i++
+			}
+		case (c == '{' || c == '}') && i+1 < end && s.text[i+1] == c && !isFormatSpec:
+			// the double braces are literals: the second brace is hidden like by the handleFSTRING_MIDDLE_token()
+			s.addToken(PythonLexerFSTRING_MIDDLE, antlr.TokenDefaultChannel, chunkStart, i+1)
+			s.addToken(PythonLexerFSTRING_MIDDLE, antlr.TokenHiddenChannel, i+1, i+2)
+			i += 2
+			chunkStart = i
+		case c == '{':
+			s.addToken(PythonLexerFSTRING_MIDDLE, antlr.TokenDefaultChannel, chunkStart, i)
+			i = s.splitReplacementField(i, end)
+			chunkStart = i
+		case c == '}' && isFormatSpec:
+			s.addToken(PythonLexerFSTRING_MIDDLE, antlr.TokenDefaultChannel, chunkStart, i)
+			return i
+		case c == '}':
+			s.p.reportLexerErrorAt(s.lines[i], s.columns[i], ErrFStringSingleBrace)
+			i++
+		default:
+			i++
+		}
+	}
+	s.addToken(PythonLexerFSTRING_MIDDLE, antlr.TokenDefaultChannel, chunkStart, end)
+	return end
+}
+
+// adds the tokens of the replacement field started at the '{', returns the index after the field
+func (s *fStringSplitter) splitReplacementField(start int, end int) int {
+	opened := s.p.opened
+	defer func() { s.p.opened = opened }()
+
+	s.addToken(PythonLexerLBRACE, antlr.TokenDefaultChannel, start, start+1)
+	s.p.opened++
+	i := s.findExpressionEnd(start+1, end)
+	s.addExpressionTokens(start+1, i)
+	if i < end && s.text[i] == '!' { // conversion: !r, !s or !a
+		conversionEnd := i + 1
+		for conversionEnd < end && unicode.IsLetter(s.text[conversionEnd]) {
+			conversionEnd++
+		}
+		s.addToken(PythonLexerFSTRING_MIDDLE, antlr.TokenDefaultChannel, i, conversionEnd)
+		i = conversionEnd
+	}
+	if i < end && s.text[i] == ':' {
+		s.addToken(PythonLexerCOLON, antlr.TokenDefaultChannel, i, i+1)
+		i = s.splitLiteral(i+1, end, true)
+	}
+	if i < end && s.text[i] == '}' {
+		s.addToken(PythonLexerRBRACE, antlr.TokenDefaultChannel, i, i+1)
+		return i + 1
+	}
+	s.p.reportLexerErrorAt(s.lines[start], s.columns[start], ErrFStringExpression) // at the '{' of the field
+	return i
+}
+
+// returns the index of the '!', ':' or '}' after the expression (outside of the brackets and the string literals)
+func (s *fStringSplitter) findExpressionEnd(start int, end int) int {
+	depth := 0
+	for i := start; i < end; i++ {
+		switch c := s.text[i]; c {
+		case '\'', '"':
+			quoteLength := 1
+			if i+2 < end && s.text[i+1] == c && s.text[i+2] == c {
+				quoteLength = 3
+			}
+			i += quoteLength
+			for i < end && !(s.text[i] == c && (quoteLength == 1 || i+2 < end && s.text[i+1] == c && s.text[i+2] == c)) {
+				i++
+			}
+			i += quoteLength - 1
+		case '(', '[', '{':
+			depth++
+		case ')', ']':
+			if depth > 0 {
+				depth--
+			}
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case '!':
+			if depth == 0 && (i+1 == end || s.text[i+1] != '=') {
+				return i
+			}
+		case ':':
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return end
+}
+
+// adds the tokens of the expression in the text[start:stop] like the checkNextToken() inside of brackets
+func (s *fStringSplitter) addExpressionTokens(start int, stop int) {
+	lexer := NewPythonLexer(antlr.NewInputStream(string(s.text[start:stop])))
+	lexer.RemoveErrorListeners()
+	for {
+		t := lexer.BaseLexer.NextToken()
+		if t.GetTokenType() == antlr.TokenEOF {
+			return
+		}
+		i := start + t.GetStart() // the index of the token in the text
+		token := s.p.newToken(s.token, t.GetTokenType(), t.GetText(),
+			t.GetChannel(), s.token.GetStart()+i, s.token.GetStart()+start+t.GetStop(), s.lines[i], s.columns[i])
+		switch token.GetTokenType() {
+		case PythonLexerLPAR, PythonLexerLSQB, PythonLexerLBRACE:
+			s.p.opened++
+			s.p.addPendingToken(token)
+		case PythonLexerRPAR, PythonLexerRSQB, PythonLexerRBRACE:
+			if s.p.opened > 0 {
+				s.p.opened--
+			}
+			s.p.addPendingToken(token)
+		case PythonLexerNEWLINE: // the expression is inside of the braces
+			s.p.hideAndAddPendingToken(token)
+		case PythonLexerSTRING:
+			if prefixLength, isFString := fStringPrefixLength(token.GetText()); isFString {
+				s.p.splitFStringLiteral(token, prefixLength)
+			} else {
+				s.p.addPendingToken(token)
+			}
+		case PythonLexerERRORTOKEN:
+			s.p.reportLexerErrorAt(token.GetLine(), token.GetColumn(), ErrTokenRecognition, token.GetText())
+			s.p.addPendingToken(token)
+		default:
+			s.p.addPendingToken(token)
+		}
+	}
+}
+
+func (p *PythonLexerBase) handleFSTRING_MIDDLE_token() { // replace the double braces '{{' or '}}' to single braces and hide the second braces
+	fsMid := p.curToken.GetText()
+	start := 0
+	for i := 0; i < len(fsMid); i++ { // split after the first brace of every {{ or }}
+		if (fsMid[i] == '{' || fsMid[i] == '}') && i+1 < len(fsMid) && fsMid[i+1] == fsMid[i] {
+			p.addFSTRING_MIDDLE_tokens(fsMid[start : i+1])
+			i++ // skip the second brace
+			start = i + 1
+		}
+	}
+	p.addFSTRING_MIDDLE_tokens(fsMid[start:])
+}
+
+func (p *PythonLexerBase) addFSTRING_MIDDLE_tokens(s string) {
+	if s != "" {
+		p.createAndAddPendingToken(PythonLexerFSTRING_MIDDLE, antlr.TokenDefaultChannel, s, p.ffgToken)
+		lastCharacter := s[len(s)-1:]
+		if strings.Contains("{}", lastCharacter) {
+			p.createAndAddPendingToken(PythonLexerFSTRING_MIDDLE, antlr.TokenHiddenChannel, lastCharacter, p.ffgToken)
+			// this inserted hidden token allows to restore the original f-string literal with the double braces
+		}
+	}
+}
+
+func (p *PythonLexerBase) handleFStringLexerModes() { // https://peps.python.org/pep-0498/#specification
+	if len(p.lexerModeStack) > 0 {
+		switch p.curToken.GetTokenType() {
+		case PythonLexerLBRACE:
+			p.PushMode(antlr.LexerDefaultMode)
+			p.parenOrBracketOpenedStack = append(p.parenOrBracketOpenedStack, 0)
+		case PythonLexerLPAR, PythonLexerLSQB:
+			// https://peps.python.org/pep-0498/#lambdas-inside-expressions
+			p.parenOrBracketOpenedStack[len(p.parenOrBracketOpenedStack)-1]++ // increment the last element
+		case PythonLexerRPAR, PythonLexerRSQB:
+			p.parenOrBracketOpenedStack[len(p.parenOrBracketOpenedStack)-1]-- // decrement the last element
+		case PythonLexerCOLON: // colon can only come from DEFAULT_MODE
+			if p.parenOrBracketOpenedStack[len(p.parenOrBracketOpenedStack)-1] == 0 {
+				switch p.lexerModeStack[len(p.lexerModeStack)-1] { // check the previous lexer mode (the current is DEFAULT_MODE)
+				case PythonLexerSINGLE_QUOTE_FSTRING_MODE,
+					PythonLexerLONG_SINGLE_QUOTE_FSTRING_MODE,
+					PythonLexerSINGLE_QUOTE_FORMAT_SPECIFICATION_MODE:
+					p.SetMode(PythonLexerSINGLE_QUOTE_FORMAT_SPECIFICATION_MODE) // continue in format spec. mode
+				case PythonLexerDOUBLE_QUOTE_FSTRING_MODE,
+					PythonLexerLONG_DOUBLE_QUOTE_FSTRING_MODE,
+					PythonLexerDOUBLE_QUOTE_FORMAT_SPECIFICATION_MODE:
+					p.SetMode(PythonLexerDOUBLE_QUOTE_FORMAT_SPECIFICATION_MODE) // continue in format spec. mode
+				}
+			}
+		case PythonLexerRBRACE:
+			switch p.lexerMode {
+			case antlr.LexerDefaultMode,
+				PythonLexerSINGLE_QUOTE_FORMAT_SPECIFICATION_MODE,
+				PythonLexerDOUBLE_QUOTE_FORMAT_SPECIFICATION_MODE:
+				p.PopMode()
+				p.parenOrBracketOpenedStack = p.parenOrBracketOpenedStack[:len(p.parenOrBracketOpenedStack)-1]
+			default:
+				p.reportLexerError(ErrFStringSingleBrace)
+			}
+		}
+	}
+}
+
+// the colon before the '}' of a replacement field in an f-string is followed by an empty format specification
+func (p *PythonLexerBase) isFormatSpecificationColon() bool {
+	return p.curToken.GetTokenType() == PythonLexerCOLON && len(p.lexerModeStack) > 0 && p.ffgToken.GetTokenType() == PythonLexerRBRACE
+}
+
+func (p *PythonLexerBase) handleFORMAT_SPECIFICATION_MODE() {
+	if len(p.lexerModeStack) > 0 &&
+		p.ffgToken.GetTokenType() == PythonLexerRBRACE {
+
+		switch p.curToken.GetTokenType() {
+		case PythonLexerCOLON, PythonLexerRBRACE:
+			// insert an empty FSTRING_MIDDLE token instead of the missing format specification
+			p.addPendingToken(p.createToken(PythonLexerFSTRING_MIDDLE, antlr.TokenDefaultChannel, "", p.ffgToken))
+		}
+	}
+}
+
+func (p *PythonLexerBase) insertTrailingTokens() {
+	switch p.lastPendingTokenTypeFromDefaultChannel {
+	case PythonLexerNEWLINE, PythonLexerDEDENT:
+		// no trailing NEWLINE token is needed
+	default:
+		// insert an extra trailing NEWLINE token that serves as the end of the last statement
+		p.createAndAddPendingToken(PythonLexerNEWLINE, antlr.TokenDefaultChannel, "", p.ffgToken) // ffgToken is EOF
+	}
+	p.insertIndentOrDedentToken(p.trailingIndentLength()) // Now insert as much trailing DEDENT tokens as needed
+}
+
+// the base indentation if its level is still open (see SetBaseIndent), otherwise 0
+// (the base level is replaced by a lower indentation after an inconsistent dedent to the left of the base)
+func (p *PythonLexerBase) trailingIndentLength() int {
+	if p.baseIndent > 0 && len(p.indentLengthStack) > 1 && p.indentLengthStack[1] <= p.baseIndent {
+		return p.indentLengthStack[1]
+	}
+	return 0
+}
+
+func (p *PythonLexerBase) handleEOFtoken() {
+	if p.lastPendingTokenTypeFromDefaultChannel > 0 {
+		// there was statement in the input (leading NEWLINE tokens are hidden)
+		if p.needsMoreInput || p.isIncompleteInteractiveInput() {
+			p.needsMoreInput = true // the statement continues in the next input, no trailing tokens
+		} else {
+			p.insertTrailingTokens()
+		}
+	}
+	if p.emitEndmarker && !p.needsMoreInput {
+		p.createAndAddPendingToken(PythonLexerENDMARKER, antlr.TokenDefaultChannel, "", p.curToken)
+	}
+	p.addPendingToken(p.curToken)
+}
+
+// the interactive input ends in an open block, in brackets or after a compound statement header (not after a blank line)
+func (p *PythonLexerBase) isIncompleteInteractiveInput() bool {
+	return p.interactiveMode && p.ffgToken.GetTokenType() == antlr.TokenEOF && !p.isAtBlankLine &&
+		(p.indentLengthStack[len(p.indentLengthStack)-1] > p.trailingIndentLength() || p.opened > 0 || p.lastPendingTokenTypeFromDefaultChannel == PythonLexerCOLON)
+}
+
+func (p *PythonLexerBase) hideAndAddPendingToken(token antlr.Token) {
+	p.addPendingToken(p.copyToken(token, antlr.TokenHiddenChannel))
+}
+
+// creates a copy of the token on the given channel (the Go runtime has no setter for the channel)
+func (p *PythonLexerBase) copyToken(token antlr.Token, channel int) antlr.Token {
+	tokenCopy := p.newToken(token, token.GetTokenType(), token.GetText(),
+		channel, token.GetStart(), token.GetStop(), token.GetLine(), token.GetColumn())
+	if t, ok := token.(*coalescedDedentToken); ok {
+		return &coalescedDedentToken{tokenCopy, t.count}
+	}
+	return tokenCopy
+}
+
+// creates a zero-length token before the sample token
+func (p *PythonLexerBase) createToken(ttype int, channel int, text string, sampleToken antlr.Token) antlr.Token {
+	return p.newToken(sampleToken, ttype, text,
+		channel, sampleToken.GetStart(), sampleToken.GetStart()-1, sampleToken.GetLine(), sampleToken.GetColumn())
+}
+
+func (p *PythonLexerBase) newToken(sampleToken antlr.Token, ttype int, text string, channel, start, stop, line, column int) antlr.Token {
+	if !p.usePool && text != "" {
+		return antlr.CommonTokenFactoryDEFAULT.Create(sampleToken.GetSource(), ttype, text,
+			channel, start, stop, line, column)
+	}
+
+	var t *pooledToken
+	if p.usePool {
+		t = tokenPool.Get().(*pooledToken)
+	} else {
+		t = new(pooledToken) // an empty text would be replaced by the text of the input or "<EOF>" in an antlr.CommonToken
+	}
+	*t = pooledToken{source: sampleToken.GetSource(), tokenSource: sampleToken.GetTokenSource(), input: sampleToken.GetInputStream(),
+		tokenType: ttype, channel: channel, start: start, stop: stop, tokenIndex: -1, line: line, column: column, text: text}
+	return t
+}
+
+// a reusable token for SetTokenPooling (the fields of antlr.CommonToken can not be reset),
+// it is used for the tokens with an empty text too
+type pooledToken struct {
+	source      *antlr.TokenSourceCharStreamPair
+	tokenSource antlr.TokenSource
+	input       antlr.CharStream
+	tokenType   int
+	channel     int
+	start       int
+	stop        int
+	tokenIndex  int
+	line        int
+	column      int
+	text        string
+}
+
+var tokenPool = sync.Pool{New: func() any { return new(pooledToken) }}
+
+func (t *pooledToken) GetSource() *antlr.TokenSourceCharStreamPair { return t.source }
+func (t *pooledToken) GetTokenType() int                           { return t.tokenType }
+func (t *pooledToken) GetChannel() int                             { return t.channel }
+func (t *pooledToken) GetStart() int                               { return t.start }
+func (t *pooledToken) GetStop() int                                { return t.stop }
+func (t *pooledToken) GetLine() int                                { return t.line }
+func (t *pooledToken) GetColumn() int                              { return t.column }
+func (t *pooledToken) GetText() string                             { return t.text }
+func (t *pooledToken) SetText(text string)                         { t.text = text }
+func (t *pooledToken) GetTokenIndex() int                          { return t.tokenIndex }
+func (t *pooledToken) SetTokenIndex(v int)                         { t.tokenIndex = v }
+func (t *pooledToken) GetTokenSource() antlr.TokenSource           { return t.tokenSource }
+func (t *pooledToken) GetInputStream() antlr.CharStream            { return t.input }
+
+func (t *pooledToken) String() string { // in the same format as antlr.CommonToken
+	txt := t.text
+	if txt != "" {
+		txt = strings.NewReplacer("\n", "\\n", "\r", "\\r", "\t", "\\t").Replace(txt)
+	} else {
+		txt = "<no text>"
+	}
+	var ch string
+	if t.channel > 0 {
+		ch = ",channel=" + strconv.Itoa(t.channel)
+	}
+	return "[@" + strconv.Itoa(t.tokenIndex) + "," + strconv.Itoa(t.start) + ":" + strconv.Itoa(t.stop) + "='" +
+		txt + "',<" + strconv.Itoa(t.tokenType) + ">" + ch + "," + strconv.Itoa(t.line) + ":" + strconv.Itoa(t.column) + "]"
+}
+
+// returns the text of an inserted token (see SetSyntheticTokenText)
+func (p *PythonLexerBase) syntheticTokenTextOf(ttype int) string {
+	if p.syntheticTokenText != nil {
+		return p.syntheticTokenText(ttype)
+	}
+	return "<" + p.SymbolicName(ttype) + ">"
+}
+
+// SymbolicName returns the symbolic name of the token type (e.g. "INDENT" for PythonLexerINDENT, "EOF" for antlr.TokenEOF),
+// or an empty string for an invalid token type.
+func (p *PythonLexerBase) SymbolicName(ttype int) string {
+	if ttype == antlr.TokenEOF {
+		return "EOF"
+	}
+	symbolicNames := p.GetSymbolicNames()
+	if ttype < 0 || ttype >= len(symbolicNames) {
+		return ""
+	}
+	return symbolicNames[ttype]
+}
+
+func (p *PythonLexerBase) createAndAddPendingToken(ttype int, channel int, text string, sampleToken antlr.Token) {
+	if text == "" {
+		text = p.syntheticTokenTextOf(ttype)
+	}
+	p.addPendingToken(p.createToken(ttype, channel, text, sampleToken))
+}
+
+func (p *PythonLexerBase) addPendingToken(token antlr.Token) {
+	token = p.acceptToken(token)
+	p.pendingTokens.push(token)
+}
+
+// updates the last pending token types by the token that is queued or returned directly by NextToken,
+// returns the token to emit (the WS and type comment tokens may be replaced)
+func (p *PythonLexerBase) acceptToken(token antlr.Token) antlr.Token {
+	if p.isWSVisible && token.GetTokenType() == PythonLexerWS && token.GetChannel() != antlr.TokenDefaultChannel {
+		token = p.copyToken(token, antlr.TokenDefaultChannel)
+	}
+	if p.emitTypeComments && token.GetTokenType() == PythonLexerCOMMENT && typeCommentRegexp.MatchString(token.GetText()) {
+		token = p.newToken(token, PythonLexerTYPE_COMMENT, token.GetText(),
+			token.GetChannel(), token.GetStart(), token.GetStop(), token.GetLine(), token.GetColumn())
+	}
+
+	// save the last pending token type because the pendingTokens queue can be empty by the NextToken()
+	p.previousPendingTokenType = token.GetTokenType()
+	switch {
+	case token.GetChannel() != antlr.TokenDefaultChannel:
+	case token.GetTokenType() == PythonLexerWS: // a visible WS is not a statement
+	case token.GetTokenType() == antlr.TokenEOF, token.GetTokenType() == PythonLexerENDMARKER:
+		// the end of the input is not a statement: the last default type is kept for AppendInput
+	default:
+		p.lastPendingTokenTypeFromDefaultChannel = p.previousPendingTokenType
+		if p.dedentPositionMode == DedentAtPreviousLineEnd && token.GetTokenType() != PythonLexerNEWLINE && token.GetStop() >= token.GetStart() {
+			p.setLastTokenEnd(token)
+		}
+	}
+	if p.OnEmit != nil {
+		p.OnEmit(token)
+	}
+	return token
+}
+
+func (p *PythonLexerBase) getIndentationLength(textWS string) int { // the textWS may contain spaces, tabs or form feeds
+	tabLength := p.TabLength()
+	length := 0
+	for i := 0; i < len(textWS); i++ { // by bytes: the bytes of a multi-byte UTF-8 sequence can not be ' ', '\t' or '\f'
+		switch textWS[i] {
+		case ' ':
+			p.wasSpaceIndentation = true
+			length += 1
+		case '\t':
+			p.wasTabIndentation = true
+			length += tabLength - (length % tabLength)
+		case '\f': // form feed
+			if p.formFeedMode == FormFeedReset {
+				length = 0
+			}
+		case '\r': // a line ending is not part of the indentation, the length is unchanged
+		}
+	}
+
+	if p.wasTabIndentation && p.wasSpaceIndentation {
+		if !p.wasIndentationMixedWithSpacesAndTabs {
+			p.wasIndentationMixedWithSpacesAndTabs = true
+			return invalidLength // only for the first inconsistent indent
+		}
+	}
+	return length
+}
+
+// returns the message of the code (see SetMessages), the arguments are formatted by fmt.Sprintf
+func (p *PythonLexerBase) message(code LexerErrorCode, args ...interface{}) string {
+	format, ok := p.messages[string(code)]
+	if !ok {
+		format = defaultMessages[code]
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+func (p *PythonLexerBase) reportLexerError(code LexerErrorCode, args ...interface{}) {
+	p.reportLexerErrorAt(p.curToken.GetLine(), p.curToken.GetColumn(), code, args...)
+}
+
+// reports an error at a position inside of the current token (e.g. a brace of an f-string literal)
+func (p *PythonLexerBase) reportLexerErrorAt(line int, column int, code LexerErrorCode, args ...interface{}) {
+	errMsg := p.message(code, args...)
+	lexerError := LexerError{line, column, errMsg, code.Kind(), code}
+	p.errors = append(p.errors, lexerError)
+	e := &LexerException{lexerError, p.curToken, p.GetInputStream()}
+	p.GetErrorListenerDispatch().SyntaxError(p, p.curToken, line, column, " LEXER"+errTxt+errMsg, e)
+}
+
+func (p *PythonLexerBase) reportError(code LexerErrorCode, args ...interface{}) {
+	p.reportLexerError(code, args...)
+	if p.errorRecovery { // only the diagnostic is reported
+		return
+	}
+
+	// the ERRORTOKEN will raise an error in the parser
+	p.createAndAddPendingToken(PythonLexerERRORTOKEN, antlr.TokenDefaultChannel, errTxt+p.message(code, args...), p.ffgToken)
+}
+
+func (p *PythonLexerBase) reportWarning(code LexerErrorCode, args ...interface{}) { // the warnings do not insert ERRORTOKEN
+	warningMsg := p.message(code, args...)
+	lexerWarning := LexerError{p.curToken.GetLine(), p.curToken.GetColumn(), warningMsg, code.Kind(), code}
+	p.warnings = append(p.warnings, lexerWarning)
+	e := &LexerException{lexerWarning, p.curToken, p.GetInputStream()}
+	for _, listener := range p.warningListeners {
+		listener.SyntaxWarning(p, p.curToken, p.curToken.GetLine(), p.curToken.GetColumn(), " LEXER"+warningTxt+warningMsg, e)
+	}
+}
+
+// PushMode, PopMode and SetMode keep track of the lexer modes for the f-string handling
+func (p *PythonLexerBase) PushMode(m int) {
+	p.lexerModeStack = append(p.lexerModeStack, p.lexerMode)
+	p.lexerMode = m
+	p.BaseLexer.PushMode(m)
+}
+
+func (p *PythonLexerBase) PopMode() int {
+	p.lexerMode = p.lexerModeStack[len(p.lexerModeStack)-1]
+	p.lexerModeStack = p.lexerModeStack[:len(p.lexerModeStack)-1]
+	return p.BaseLexer.PopMode()
+}
+
+func (p *PythonLexerBase) SetMode(m int) {
+	p.lexerMode = m
+	p.BaseLexer.SetMode(m)
+}
+
+func (p *PythonLexerBase) Reset() {
+	p.init()
+	p.BaseLexer.Reset()
+}
+
+// ResetIndentationState clears the indentation stack, the bracket counts, the match blocks of the soft keywords
+// and the last pending token types without rewinding the input (unlike Reset),
+// e.g. for an interactive shell where each top-level entry is independent.
+// The next token is handled as the start of the input, the tokens read ahead for the soft keywords are lexed again.
+// The lexer modes, the pending tokens, the errors and the options are kept.
+func (p *PythonLexerBase) ResetIndentationState() {
+	if len(p.lookaheadTokens) > 0 { // the lookahead ends at a line end, so the lexer modes are the same at its first token
+		first := p.lookaheadTokens[0]
+		p.seekInput(first.GetStart(), first.GetLine(), first.GetColumn())
+		p.lookaheadTokens = nil
+	}
+	p.indentLengthStack = nil
+	p.indentTextStack = nil
+	p.pendingIndentChanges = nil
+	p.previousPendingTokenType = 0
+	p.lastPendingTokenTypeFromDefaultChannel = 0
+	p.opened = 0
+	p.parenOrBracketOpenedStack = nil
+	p.matchLevels = nil
+	p.wasSpaceIndentation = false
+	p.wasTabIndentation = false
+	p.wasIndentationMixedWithSpacesAndTabs = false
+	p.isAtBlankLine = false
+	p.needsMoreInput = false
+	p.isNEWLINEqueued = false
+}
+
+// Clone returns the lexer base of a new PythonLexer that continues producing the same tokens as this lexer,
+// e.g. to try lexing a hypothetical continuation and then discard it (the clone does not affect the original).
+// Copied: the indentation and bracket state, the lexer modes, the line and column, the pending tokens (bound to the clone),
+// the reported errors and warnings, the options.
+// Shared: the OnIndent/OnDedent/OnEmit hooks and the error and warning listeners (the clone dispatches to the listeners of the original).
+// The input is re-wrapped into a new antlr.InputStream with the same text and position.
+func (p *PythonLexerBase) Clone() *PythonLexerBase {
+	input := p.GetInputStream()
+	fork := NewPythonLexer(antlr.NewInputStream(input.GetText(0, input.Size()-1)))
+	fork.RemoveErrorListeners()
+	fork.AddErrorListener(p.GetErrorListenerDispatch())
+
+	c := &fork.PythonLexerBase
+	baseLexer := c.BaseLexer
+	*c = *p
+	c.BaseLexer = baseLexer
+	c.seekInput(input.Index(), p.Interpreter.GetLine(), p.Interpreter.GetCharPositionInLine())
+	c.setLexerModes(p.lexerModeStack, p.lexerMode)
+	c.indentLengthStack = append([]int(nil), p.indentLengthStack...)
+	c.indentTextStack = append([]string(nil), p.indentTextStack...)
+	c.parenOrBracketOpenedStack = append([]int(nil), p.parenOrBracketOpenedStack...)
+	c.errors = append([]LexerError(nil), p.errors...)
+	c.warnings = append([]LexerError(nil), p.warnings...)
+	c.lineIndents = p.LineIndents()
+
+	// the tokens are copied because the token streams set their token index,
+	// the copies are bound to the clone by the token source of an EOF token (like by RestoreState)
+	sampleToken := c.BaseLexer.EmitEOF()
+	copies := make(map[antlr.Token]antlr.Token)
+	copyOf := func(token antlr.Token) antlr.Token {
+		if token == nil {
+			return nil
+		}
+		if tokenCopy, ok := copies[token]; ok {
+			return tokenCopy
+		}
+		tokenCopy := c.newToken(sampleToken, token.GetTokenType(), token.GetText(),
+			token.GetChannel(), token.GetStart(), token.GetStop(), token.GetLine(), token.GetColumn())
+		if t, ok := token.(*coalescedDedentToken); ok {
+			tokenCopy = &coalescedDedentToken{tokenCopy, t.count}
+		}
+		copies[token] = tokenCopy
+		return tokenCopy
+	}
+	c.pendingTokens = tokenQueue{}
+	for _, token := range p.pendingTokens.tokens[p.pendingTokens.head:] {
+		c.pendingTokens.push(copyOf(token))
+	}
+	c.pendingIndentChanges = make([]indentChange, len(p.pendingIndentChanges))
+	for i, change := range p.pendingIndentChanges {
+		change.token = copyOf(change.token)
+		c.pendingIndentChanges[i] = change
+	}
+	c.curToken = copyOf(p.curToken)
+	c.ffgToken = copyOf(p.ffgToken)
+	c.lookaheadTokens = make([]antlr.Token, len(p.lookaheadTokens))
+	for i, token := range p.lookaheadTokens {
+		c.lookaheadTokens[i] = copyOf(token)
+	}
+	c.matchLevels = append([]int(nil), p.matchLevels...)
+	c.warningListeners = append([]WarningListener(nil), p.warningListeners...)
+	if p.mutex != nil {
+		c.mutex = new(sync.Mutex)
+	}
+	return c
+}
+
+// LexerState is a serializable snapshot of the lexer state (see SaveState and RestoreState).
+type LexerState struct {
+	IndentLengthStack                      []int
+	IndentTextStack                        []string // only for SetStrictIndentConsistency
+	Opened                                 int
+	ParenOrBracketOpenedStack              []int
+	LexerModeStack                         []int
+	LexerMode                              int
+	WasSpaceIndentation                    bool
+	WasTabIndentation                      bool
+	WasIndentationMixedWithSpacesAndTabs   bool
+	PreviousPendingTokenType               int
+	LastPendingTokenTypeFromDefaultChannel int
+	DeclaredEncoding                       string
+	LastTokenEndIndex                      int // the position after the last token of the default channel
+	LastTokenEndLine                       int
+	LastTokenEndColumn                     int
+	IsNEWLINEqueued                        bool // the NEWLINE token of the statement line is queued at the end of the previous input chunk
+
+	// the position of the lexer in the input stream
+	InputIndex int
+	Line       int
+	Column     int
+
+	PendingTokens        []TokenState // the tokens that are not returned by NextToken yet
+	PendingIndentChanges []IndentChangeState
+	FollowingToken       *TokenState  // the look ahead token (nil before the first token)
+	LookaheadTokens      []TokenState // the tokens after the FollowingToken that are already read from the input
+	MatchLevels          []int
+}
+
+// TokenState is a serializable token of a LexerState.
+type TokenState struct {
+	Type    int
+	Channel int
+	Start   int
+	Stop    int
+	Line    int
+	Column  int
+	Text    string
+	Count   int // the levels closed by a coalesced DEDENT token (see SetCoalesceDedents), otherwise 0
+}
+
+// IndentChangeState is a serializable indentation stack change of a pending INDENT or DEDENT token.
+type IndentChangeState struct {
+	PendingToken int // the index of the token in LexerState.PendingTokens
+	IndentLength int
+	IsPush       bool
+}
+
+// SaveState returns the state of the lexer that can be restored later by RestoreState (e.g. on a new lexer of the same input).
+// The options, the hooks, the error listeners and the reported errors and warnings are not part of the state.
+func (p *PythonLexerBase) SaveState() LexerState {
+	state := LexerState{
+		IndentLengthStack:                      append([]int(nil), p.indentLengthStack...),
+		IndentTextStack:                        append([]string(nil), p.indentTextStack...),
+		Opened:                                 p.opened,
+		ParenOrBracketOpenedStack:              append([]int(nil), p.parenOrBracketOpenedStack...),
+		LexerModeStack:                         append([]int(nil), p.lexerModeStack...),
+		LexerMode:                              p.lexerMode,
+		WasSpaceIndentation:                    p.wasSpaceIndentation,
+		WasTabIndentation:                      p.wasTabIndentation,
+		WasIndentationMixedWithSpacesAndTabs:   p.wasIndentationMixedWithSpacesAndTabs,
+		PreviousPendingTokenType:               p.previousPendingTokenType,
+		LastPendingTokenTypeFromDefaultChannel: p.lastPendingTokenTypeFromDefaultChannel,
+		DeclaredEncoding:                       p.declaredEncoding,
+		LastTokenEndIndex:                      p.lastTokenEndIndex,
+		LastTokenEndLine:                       p.lastTokenEndLine,
+		LastTokenEndColumn:                     p.lastTokenEndColumn,
+		IsNEWLINEqueued:                        p.isNEWLINEqueued,
+		InputIndex:                             p.GetInputStream().Index(),
+		Line:                                   p.Interpreter.GetLine(),
+		Column:                                 p.Interpreter.GetCharPositionInLine(),
+	}
+
+	pendingIndexes := make(map[antlr.Token]int)
+	for i, token := range p.pendingTokens.tokens[p.pendingTokens.head:] {
+		pendingIndexes[token] = i
+		state.PendingTokens = append(state.PendingTokens, newTokenState(token))
+	}
+	for _, change := range p.pendingIndentChanges {
+		state.PendingIndentChanges = append(state.PendingIndentChanges,
+			IndentChangeState{PendingToken: pendingIndexes[change.token], IndentLength: change.indentLength, IsPush: change.isPush})
+	}
+	if p.ffgToken != nil {
+		ffgState := newTokenState(p.ffgToken)
+		state.FollowingToken = &ffgState
+	}
+	for _, token := range p.lookaheadTokens {
+		state.LookaheadTokens = append(state.LookaheadTokens, newTokenState(token))
+	}
+	state.MatchLevels = append([]int(nil), p.matchLevels...)
+	return state
+}
+
+// RestoreState restores a state returned by SaveState, then the lexer continues producing the same tokens as the saved lexer.
+// The input stream must have the same text as the input of the saved lexer (at least up to the saved position).
+// The reported errors and warnings are cleared, the options are kept.
+// A state with an empty indentation stack is rejected, unless it is saved before the first token.
+func (p *PythonLexerBase) RestoreState(state LexerState) error {
+	isBeforeFirstToken := state.FollowingToken == nil && len(state.PendingTokens) == 0 && len(state.LookaheadTokens) == 0
+	if len(state.IndentLengthStack) == 0 && !isBeforeFirstToken {
+		return fmt.Errorf("invalid lexer state: the indentation stack is empty")
+	}
+	for _, change := range state.PendingIndentChanges {
+		if change.PendingToken < 0 || change.PendingToken >= len(state.PendingTokens) {
+			return fmt.Errorf("invalid lexer state: pending token index out of range: %d", change.PendingToken)
+		}
+	}
+
+	p.Reset()
+	p.seekInput(state.InputIndex, state.Line, state.Column)
+	p.setLexerModes(state.LexerModeStack, state.LexerMode)
+	p.indentLengthStack = append([]int(nil), state.IndentLengthStack...)
+	p.indentTextStack = append([]string(nil), state.IndentTextStack...)
+	p.opened = state.Opened
+	p.parenOrBracketOpenedStack = append([]int(nil), state.ParenOrBracketOpenedStack...)
+	p.wasSpaceIndentation = state.WasSpaceIndentation
+	p.wasTabIndentation = state.WasTabIndentation
+	p.wasIndentationMixedWithSpacesAndTabs = state.WasIndentationMixedWithSpacesAndTabs
+	p.previousPendingTokenType = state.PreviousPendingTokenType
+	p.lastPendingTokenTypeFromDefaultChannel = state.LastPendingTokenTypeFromDefaultChannel
+	p.declaredEncoding = state.DeclaredEncoding
+	p.lastTokenEndIndex, p.lastTokenEndLine, p.lastTokenEndColumn = state.LastTokenEndIndex, state.LastTokenEndLine, state.LastTokenEndColumn
+	p.isNEWLINEqueued = state.IsNEWLINEqueued
+
+	// the token source of the lexer is not accessible directly, it is taken from an EOF token
+	// (the BaseLexer drops it at the next token)
+	sampleToken := p.BaseLexer.EmitEOF()
+	pendingTokens := make([]antlr.Token, len(state.PendingTokens))
+	for i, tokenState := range state.PendingTokens {
+		pendingTokens[i] = tokenState.newToken(p, sampleToken)
+		p.pendingTokens.push(pendingTokens[i])
+	}
+	for _, change := range state.PendingIndentChanges {
+		p.pendingIndentChanges = append(p.pendingIndentChanges,
+			indentChange{token: pendingTokens[change.PendingToken], indentLength: change.IndentLength, isPush: change.IsPush})
+	}
+	if state.FollowingToken != nil {
+		p.ffgToken = state.FollowingToken.newToken(p, sampleToken)
+	}
+	for _, tokenState := range state.LookaheadTokens {
+		p.lookaheadTokens = append(p.lookaheadTokens, tokenState.newToken(p, sampleToken))
+	}
+	p.matchLevels = append([]int(nil), state.MatchLevels...)
+	return nil
+}
+
+func newTokenState(token antlr.Token) TokenState {
+	tokenState := TokenState{Type: token.GetTokenType(), Channel: token.GetChannel(), Start: token.GetStart(), Stop: token.GetStop(),
+		Line: token.GetLine(), Column: token.GetColumn(), Text: token.GetText()}
+	if t, ok := token.(*coalescedDedentToken); ok {
+		tokenState.Count = t.count
+	}
+	return tokenState
+}
+
+func (t TokenState) newToken(p *PythonLexerBase, sampleToken antlr.Token) antlr.Token {
+	token := p.newToken(sampleToken, t.Type, t.Text, t.Channel, t.Start, t.Stop, t.Line, t.Column)
+	if t.Count > 1 {
+		return &coalescedDedentToken{token, t.Count}
+	}
+	return token
+}
+
+// sets the position of the BaseLexer in the input stream (the line and the column are kept by its LexerATNSimulator)
+func (p *PythonLexerBase) seekInput(index, line, column int) {
+	p.GetInputStream().Seek(index)
+	if sim, ok := p.Interpreter.(*antlr.LexerATNSimulator); ok {
+		sim.Line = line
+		sim.CharPositionInLine = column
+	}
+}
+
+// sets the lexer modes of a lexer with an empty mode stack
+func (p *PythonLexerBase) setLexerModes(modeStack []int, mode int) {
+	p.lexerModeStack = append([]int(nil), modeStack...)
+	p.lexerMode = mode
+	// rebuild the mode stack of the BaseLexer (it is not accessible directly)
+	if len(modeStack) == 0 {
+		p.BaseLexer.SetMode(mode)
+		return
+	}
+	p.BaseLexer.SetMode(modeStack[0])
+	for _, m := range modeStack[1:] {
+		p.BaseLexer.PushMode(m)
+	}
+	p.BaseLexer.PushMode(mode)
+}
+
+// DumpTokens writes all the tokens of the input from its beginning, one token per line in the
+// line:column TYPE(channel) 'text' format, the inserted (zero-length) tokens are marked as synthetic.
+// The tokens are produced by a reset clone of the lexer, so this lexer is not affected.
+func (p *PythonLexerBase) DumpTokens(w io.Writer) error {
+	c := p.Clone()
+	c.OnIndent, c.OnDedent, c.OnEmit = nil, nil, nil
+	c.RemoveErrorListeners() // the errors are dumped as ERRORTOKEN
+	c.Reset()
+	textReplacer := strings.NewReplacer("\r", "\\r", "\n", "\\n", "\t", "\\t", "\f", "\\f")
+	for {
+		token := c.NextToken()
+		tokenName := c.SymbolicName(token.GetTokenType())
+		synthetic := ""
+		if token.GetStop() < token.GetStart() && token.GetTokenType() != antlr.TokenEOF {
+			synthetic = " synthetic"
+		}
+		_, err := fmt.Fprintf(w, "%d:%d %s(%d) '%s'%s\n", token.GetLine(), token.GetColumn(),
+			tokenName, token.GetChannel(), textReplacer.Replace(token.GetText()), synthetic)
+		if err != nil {
+			return err
+		}
+		if token.GetTokenType() == antlr.TokenEOF {
+			return nil
+		}
+	}
+}
+
+// ReportedError is a syntax error or a lexer warning collected by an IndentationErrorListener.
+type ReportedError struct {
+	Line       int
+	Column     int
+	Message    string         // without the " LEXER ERROR: " or " LEXER WARNING: " prefix
+	Code       LexerErrorCode // empty for the errors of the parser
+	IsWarning  bool
+	SourceLine string // the text of the line of the error without the line ending
+}
+
+// IndentationErrorListener is an antlr.ErrorListener that collects the errors of the lexer and the parser,
+// and a WarningListener that collects the warnings of the lexer.
+type IndentationErrorListener struct {
+	*antlr.DefaultErrorListener
+	errors []ReportedError
+
+	// the lines of the last input
+	input antlr.CharStream
+	lines []string
+}
+
+// NewIndentationErrorListener returns an error listener for the AddErrorListener of the lexer and the parser
+// (and for the AddWarningListener of the lexer).
+func NewIndentationErrorListener() *IndentationErrorListener {
+	return &IndentationErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+}
+
+func (l *IndentationErrorListener) SyntaxError(_ antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	reportedError := ReportedError{Line: line, Column: column, Message: msg}
+	if lexerException, ok := e.(*LexerException); ok {
+		reportedError.Code = lexerException.Code
+	}
+	if message, ok := strings.CutPrefix(msg, " LEXER"+errTxt); ok {
+		reportedError.Message = message
+	} else if message, ok := strings.CutPrefix(msg, " LEXER"+warningTxt); ok {
+		reportedError.Message = message
+		reportedError.IsWarning = true
+	}
+	if token, ok := offendingSymbol.(antlr.Token); ok && token.GetInputStream() != nil {
+		reportedError.SourceLine = l.sourceLine(token.GetInputStream(), line)
+	}
+	l.errors = append(l.errors, reportedError)
+}
+
+// SyntaxWarning collects a warning of the lexer like an error with the IsWarning flag.
+func (l *IndentationErrorListener) SyntaxWarning(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	l.SyntaxError(recognizer, offendingSymbol, line, column, msg, e)
+}
+
+// returns the text of the line (numbered from 1) of the input
+func (l *IndentationErrorListener) sourceLine(input antlr.CharStream, line int) string {
+	if input != l.input {
+		l.input = input
+		l.lines = strings.Split(input.GetText(0, input.Size()-1), "\n") // the lines of the token positions
+	}
+	if line < 1 || line > len(l.lines) {
+		return ""
+	}
+	return strings.TrimSuffix(l.lines[line-1], "\r")
+}
+
+// Errors returns the collected errors and warnings in the order of their reports.
+func (l *IndentationErrorListener) Errors() []ReportedError {
+	return append([]ReportedError(nil), l.errors...)
+}
+
+// Formatted returns the collected errors and warnings as "line L:C message" texts followed by the source line
+// and a caret under the column of the error, e.g. for the indentation errors:
+//
+//	line 3:0 inconsistent use of tabs and spaces in indentation
+//		j = 0
+//	^
+func (l *IndentationErrorListener) Formatted() []string {
+	formatted := make([]string, 0, len(l.errors))
+	for _, reportedError := range l.errors {
+		text := fmt.Sprintf("line %d:%d %s", reportedError.Line, reportedError.Column, reportedError.Message)
+		if reportedError.IsWarning {
+			text = fmt.Sprintf("line %d:%d warning: %s", reportedError.Line, reportedError.Column, reportedError.Message)
+		}
+		if reportedError.SourceLine != "" {
+			var caretIndent strings.Builder // the tabs are kept to align the caret
+			for i, c := range []rune(reportedError.SourceLine) {
+				if i >= reportedError.Column {
+					break
+				}
+				if c == '\t' {
+					caretIndent.WriteRune('\t')
+				} else {
+					caretIndent.WriteRune(' ')
+				}
+			}
+			text += "\n" + reportedError.SourceLine + "\n" + caretIndent.String() + "^"
+		}
+		formatted = append(formatted, text)
+	}
+	return formatted
+}
@@ -0,0 +1,1290 @@
+package parser
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// newTestLexer returns a lexer of src without the console error listener.
+func newTestLexer(src string) *PythonLexer {
+	lexer := NewPythonLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	return lexer
+}
+
+// lexAll returns all the tokens of the lexer up to and including the EOF token.
+func lexAll(lexer antlr.TokenSource) []antlr.Token {
+	var tokens []antlr.Token
+	for {
+		token := lexer.NextToken()
+		tokens = append(tokens, token)
+		if token.GetTokenType() == antlr.TokenEOF {
+			return tokens
+		}
+	}
+}
+
+// defaultTypes returns the symbolic names of the default channel tokens separated by spaces.
+func defaultTypes(lexer *PythonLexer, tokens []antlr.Token) string {
+	var names []string
+	for _, token := range tokens {
+		if token.GetChannel() == antlr.TokenDefaultChannel {
+			names = append(names, lexer.SymbolicName(token.GetTokenType()))
+		}
+	}
+	return strings.Join(names, " ")
+}
+
+func TestGolden(t *testing.T) {
+	files, err := filepath.Glob("../tests/*.py")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = append(files, "../example.py")
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			input, err := antlr.NewFileStream(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got bytes.Buffer
+			if err := NewPythonLexer(input).DumpTokens(&got); err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", strings.TrimSuffix(filepath.Base(file), ".py")+".tokens")
+			if *update {
+				if err := os.WriteFile(golden, got.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("tokens differ from %s:\n%s", golden, got.String())
+			}
+		})
+	}
+}
+
+func TestNextTokenAfterEOF(t *testing.T) {
+	for _, src := range []string{"", "x = 1\n", "if x:\n    y\n", "f(\n"} {
+		lexer := newTestLexer(src)
+		tokens := lexAll(lexer)
+		eof := tokens[len(tokens)-1]
+		for i := 0; i < 3; i++ {
+			if token := lexer.NextToken(); token != eof {
+				t.Errorf("%q: NextToken after EOF = %v, want the EOF token %v", src, token, eof)
+			}
+		}
+	}
+}
+
+func TestLastDefaultChannelTokenType(t *testing.T) {
+	tests := []struct {
+		src       string
+		endmarker bool
+		want      int
+	}{
+		{"", false, 0},
+		{"# comment\n", false, 0},
+		{"x = 1\n", false, PythonLexerNEWLINE},
+		{"x = 1", false, PythonLexerNEWLINE}, // the inserted trailing NEWLINE
+		{"if x:\n    y\n", false, PythonLexerDEDENT},
+		{"x = 1\n", true, PythonLexerNEWLINE},
+		{"if x:\n    y\n", true, PythonLexerDEDENT},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer(tt.src)
+		lexer.SetEmitEndmarker(tt.endmarker)
+		lexAll(lexer)
+		if got := lexer.LastDefaultChannelTokenType(); got != tt.want {
+			t.Errorf("%q (endmarker: %v): LastDefaultChannelTokenType() = %s, want %s",
+				tt.src, tt.endmarker, lexer.SymbolicName(got), lexer.SymbolicName(tt.want))
+		}
+	}
+}
+
+func TestAppendInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		chunks []string
+		want   []string // the default channel tokens of the chunks
+	}{
+		{"open block", []string{"if x:\n", "    y\n", "\n"},
+			[]string{"IF NAME COLON NEWLINE EOF", "INDENT NAME NEWLINE EOF", "DEDENT EOF"}},
+		{"statements", []string{"x = 1\n", "y\n"},
+			[]string{"NAME EQUAL NUMBER NEWLINE EOF", "NAME NEWLINE EOF"}},
+		{"open bracket", []string{"f(1,\n", "  2)\n"},
+			[]string{"NAME LPAR NUMBER COMMA EOF", "NUMBER RPAR NEWLINE EOF"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.chunks[0])
+			lexer.SetInteractiveMode(true)
+			for i, chunk := range tt.chunks {
+				if i > 0 {
+					if err := lexer.AppendInput(chunk); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want[i] {
+					t.Errorf("chunk %d %q: got %s, want %s", i, chunk, got, tt.want[i])
+				}
+			}
+			if lexer.NeedsMoreInput() {
+				t.Errorf("NeedsMoreInput() = true after the last chunk")
+			}
+		})
+	}
+}
+
+func TestBaseIndent(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    string
+		want   string
+		errors int
+	}{
+		{"one level", "    x\n    y\n", "NAME NEWLINE NAME NEWLINE EOF", 0},
+		{"two levels", "    if x:\n        if y:\n            z\n",
+			"IF NAME COLON NEWLINE INDENT IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT DEDENT EOF", 0},
+		{"two levels without trailing newline", "    if x:\n        if y:\n            z",
+			"IF NAME COLON NEWLINE INDENT IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT DEDENT EOF", 0},
+		{"two levels and a trailing blank line", "    if x:\n        if y:\n            z\n    \n",
+			"IF NAME COLON NEWLINE INDENT IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT DEDENT EOF", 0},
+		{"first statement left of the base", "  x\n  y\n", "NAME NEWLINE NAME NEWLINE EOF", 1},
+		{"first statement left of the base with a block", "  if x:\n      y\n  z\n",
+			"IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT NAME NEWLINE EOF", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			if err := lexer.SetBaseIndent(4); err != nil {
+				t.Fatal(err)
+			}
+			if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			if got := len(lexer.Errors()); got != tt.errors {
+				t.Errorf("got %d errors, want %d: %v", got, tt.errors, lexer.Errors())
+			}
+		})
+	}
+}
+
+func TestFinishStream(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		read     int // the number of the default channel tokens read before FinishStream
+		want     string
+		finished string
+	}{
+		{"before the first token", "if a:\n    b\n", 0, "", ""},
+		{"in a statement", "x = 1\ny\n", 1, "NAME", "NEWLINE"},
+		{"after a complete line", "x = 1\ny\n", 4, "NAME EQUAL NUMBER NEWLINE", ""},
+		{"in a block", "if a:\n    b\n", 5, "IF NAME COLON NEWLINE INDENT", "NEWLINE DEDENT"},
+		{"before an unlexed indented line", "if a:\n    if b:\n        c\n", 9,
+			"IF NAME COLON NEWLINE INDENT IF NAME COLON NEWLINE", "DEDENT"},
+		{"before an unlexed dedented line", "if a:\n    b\nc\n", 6, "IF NAME COLON NEWLINE INDENT NAME", "NEWLINE DEDENT"},
+		{"after the NEWLINE before an unlexed dedented line", "if a:\n    b\nc\n", 7,
+			"IF NAME COLON NEWLINE INDENT NAME NEWLINE", "DEDENT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			var tokens []antlr.Token
+			for n := 0; n < tt.read; {
+				token := lexer.NextToken()
+				tokens = append(tokens, token)
+				if token.GetChannel() == antlr.TokenDefaultChannel {
+					n++
+				}
+			}
+			if got := defaultTypes(lexer, tokens); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			if got := defaultTypes(lexer, lexer.FinishStream()); got != tt.finished {
+				t.Errorf("FinishStream got %q, want %q", got, tt.finished)
+			}
+			if got := lexer.FinishStream(); len(got) != 0 {
+				t.Errorf("second FinishStream got %v, want no tokens", got)
+			}
+			for i := 0; i < 2; i++ {
+				if got := lexer.NextToken(); got.GetTokenType() != antlr.TokenEOF {
+					t.Fatalf("NextToken after FinishStream got %v, want EOF", got)
+				}
+			}
+		})
+	}
+}
+
+func TestSyntheticTokenText(t *testing.T) {
+	tests := []struct {
+		name string
+		text func(ttype int) string
+		want string
+	}{
+		{"default", nil, "<INDENT> <NEWLINE> <DEDENT>"},
+		{"empty", func(int) string { return "" }, "  "},
+		{"marker", func(ttype int) string { return "@" + strconv.Itoa(ttype) }, "@" + strconv.Itoa(PythonLexerINDENT) + " @" + strconv.Itoa(PythonLexerNEWLINE) + " @" + strconv.Itoa(PythonLexerDEDENT)},
+	}
+	for _, tt := range tests {
+		for _, usePool := range []bool{false, true} {
+			t.Run(tt.name+"/pooling="+strconv.FormatBool(usePool), func(t *testing.T) {
+				lexer := newTestLexer("if a:\n    b") // the NEWLINE and DEDENT tokens are inserted at the EOF
+				lexer.SetSyntheticTokenText(tt.text)
+				lexer.SetTokenPooling(usePool)
+				var texts []string
+				for _, token := range lexAll(lexer) {
+					switch token.GetTokenType() {
+					case PythonLexerINDENT, PythonLexerDEDENT:
+						texts = append(texts, token.GetText())
+					case PythonLexerNEWLINE:
+						if token.GetLine() == 2 {
+							texts = append(texts, token.GetText())
+						}
+					}
+				}
+				if got := strings.Join(texts, " "); got != tt.want {
+					t.Errorf("got %q, want %q", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestStrictIndentConsistency(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           string
+		errorRecovery bool
+		warnings      int // WarnIndentText
+		errors        int // ErrMixedTabsSpaces
+	}{
+		{"same text", "if a:\n\tb\n\tc\n", false, 0, 0},
+		{"tab and spaces", "if a:\n\tb\n        c\n", false, 1, 1},
+		{"tab and spaces with error recovery", "if a:\n\tb\n        c\n", true, 1, 1},
+		{"spaces and tabs after the first mixed line", "if a:\n        b\n\tc\n\td\n", false, 2, 1},
+		{"different width", "if a:\n\tb\n    c\n", false, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			lexer.SetStrictIndentConsistency(true)
+			lexer.SetErrorRecovery(tt.errorRecovery)
+			lexAll(lexer)
+			for _, w := range lexer.Warnings() {
+				if w.Code != WarnIndentText {
+					t.Errorf("unexpected warning %v", w)
+				}
+			}
+			for _, e := range lexer.Errors() {
+				if e.Code != ErrMixedTabsSpaces {
+					t.Errorf("unexpected error %v", e)
+				}
+			}
+			if got := len(lexer.Warnings()); got != tt.warnings {
+				t.Errorf("got %d warnings, want %d", got, tt.warnings)
+			}
+			if got := len(lexer.Errors()); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
+
+func TestResetIndentationState(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		read int // the number of the default channel tokens read before ResetIndentationState
+		want string
+	}{
+		{"in brackets", "x = (1,\n2)\n", 4, "NAME EQUAL LPAR NUMBER COMMA NEWLINE NUMBER RPAR NEWLINE EOF"},
+		{"after a match statement", "match x:\nif y:\n    case 1:\n        pass\n", 4, // the case is not in the match block
+			"MATCH NAME COLON NEWLINE IF NAME COLON NEWLINE INDENT NAME NUMBER COLON NEWLINE INDENT PASS NEWLINE DEDENT DEDENT EOF"},
+		{"after a lookahead", "match x:\n    pass\n", 1, "MATCH INDENT NAME COLON NEWLINE INDENT PASS NEWLINE DEDENT EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			lexer.SetSoftKeywordMatch(true)
+			var tokens []antlr.Token
+			for n := 0; n < tt.read; {
+				token := lexer.NextToken()
+				tokens = append(tokens, token)
+				if token.GetChannel() == antlr.TokenDefaultChannel {
+					n++
+				}
+			}
+			lexer.ResetIndentationState()
+			tokens = append(tokens, lexAll(lexer)...)
+			if got := defaultTypes(lexer, tokens); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			var text strings.Builder
+			for _, token := range tokens {
+				if token.GetStop() >= token.GetStart() { // not an inserted token
+					text.WriteString(token.GetText())
+				}
+			}
+			if got := text.String(); got != tt.src {
+				t.Errorf("the text of the tokens is %q, want %q", got, tt.src)
+			}
+		})
+	}
+}
+
+func TestFStringExpressionModeErrorPosition(t *testing.T) {
+	tests := []struct {
+		src          string
+		code         LexerErrorCode
+		line, column int
+	}{
+		{"x = f\"a}b\"\n", ErrFStringSingleBrace, 1, 7},
+		{"x = f\"{a\"\n", ErrFStringExpression, 1, 6},
+		{"x = f\"\"\"\n  {a\"\"\"\n", ErrFStringExpression, 2, 2},
+		{"x = f\"{a $}\"\n", ErrTokenRecognition, 1, 9},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer(tt.src)
+		lexer.SetFStringExpressionMode(true)
+		lexAll(lexer)
+		errors := lexer.Errors()
+		if len(errors) != 1 {
+			t.Errorf("%q: got errors %v, want one %s error", tt.src, errors, tt.code)
+			continue
+		}
+		if e := errors[0]; e.Code != tt.code || e.Line != tt.line || e.Column != tt.column {
+			t.Errorf("%q: got %s at %d:%d, want %s at %d:%d", tt.src, e.Code, e.Line, e.Column, tt.code, tt.line, tt.column)
+		}
+	}
+}
+
+func TestClone(t *testing.T) {
+	const src = "if a:\n    b = (1,\n         2)\nc\n"
+	for read := 0; read < 12; read++ {
+		lexer := newTestLexer(src)
+		var tokens []antlr.Token
+		for i := 0; i < read; i++ {
+			tokens = append(tokens, lexer.NextToken())
+		}
+		clone := lexer.Clone()
+		cloneTokens := lexAll(clone)
+		tokens = append(tokens, lexAll(lexer)...)
+		want := defaultTypes(lexer, tokens)
+		if got := defaultTypes(lexer, append(tokens[:read:read], cloneTokens...)); got != want {
+			t.Errorf("read %d: got  %s\nwant %s", read, got, want)
+		}
+		for _, token := range cloneTokens {
+			if token.GetInputStream() != clone.GetInputStream() || token.GetTokenSource() != clone.BaseLexer {
+				t.Errorf("read %d: the token %v is not bound to the clone", read, token)
+			}
+		}
+	}
+}
+
+// errorCounter is an error listener that counts the reported errors.
+type errorCounter struct {
+	*antlr.DefaultErrorListener
+	count int
+}
+
+func (c *errorCounter) SyntaxError(antlr.Recognizer, interface{}, int, int, string, antlr.RecognitionException) {
+	c.count++
+}
+
+func TestMessages(t *testing.T) {
+	lexer := newTestLexer("if a:\n   b $\n")
+	if err := lexer.SetMessages(map[string]string{
+		string(ErrTokenRecognition): "erreur de reconnaissance de jeton : '%s'",
+		string(WarnIndentUnit):      "l'indentation de %d colonnes n'est pas un multiple de %d",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := lexer.SetIndentUnit(4); err != nil {
+		t.Fatal(err)
+	}
+	listener := NewIndentationErrorListener()
+	counter := &errorCounter{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	lexer.AddErrorListener(listener)
+	lexer.AddErrorListener(counter)
+	lexer.AddWarningListener(listener)
+	lexAll(lexer)
+
+	want := []ReportedError{
+		{Line: 2, Column: 0, Message: "l'indentation de 3 colonnes n'est pas un multiple de 4", Code: WarnIndentUnit, IsWarning: true,
+			SourceLine: "   b $"},
+		{Line: 2, Column: 5, Message: "erreur de reconnaissance de jeton : '$'", Code: ErrTokenRecognition, SourceLine: "   b $"},
+	}
+	if got := listener.Errors(); !slices.Equal(got, want) {
+		t.Errorf("got  %+v\nwant %+v", got, want)
+	}
+	if counter.count != 1 { // the warning is not an error
+		t.Errorf("the error listener got %d errors, want 1", counter.count)
+	}
+}
+
+func TestSetMessagesVerbs(t *testing.T) {
+	tests := []struct {
+		messages map[string]string
+		valid    bool
+	}{
+		{map[string]string{string(ErrMixedTabsSpaces): "utilisation incohérente des tabulations et des espaces"}, true},
+		{map[string]string{string(ErrTokenRecognition): "erreur de reconnaissance de jeton : « %s »"}, true},
+		{map[string]string{string(ErrTokenRecognition): "erreur de reconnaissance de jeton"}, false},
+		{map[string]string{string(ErrMixedTabsSpaces): "tabulations mélangées à %s"}, false},
+		{map[string]string{string(WarnIndentUnit): "%d colonnes, pas un multiple de %s"}, false},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer("")
+		if err := lexer.SetMessages(tt.messages); (err == nil) != tt.valid {
+			t.Errorf("SetMessages(%q) = %v, want valid: %v", tt.messages, err, tt.valid)
+		}
+	}
+}
+
+// generatedSource returns a Python source of n lines with nested blocks, brackets and long statement lines.
+func generatedSource(n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i += 4 {
+		b.WriteString("def f" + strconv.Itoa(i) + "(a, b=1, *args, **kwargs):\n")
+		b.WriteString("    if a and not b or a.x[0] >= b.y(1, 2) + 3 * 4 - 5 // 6:\n")
+		b.WriteString("        return {'k': [a, b, (a + b) ** 2], 'v': a if b else -b}\n")
+		b.WriteString("    x = y = z = a.b.c.d.e.f + g - h * i / j % k & l | m ^ n << o >> p\n")
+	}
+	return b.String()
+}
+
+func BenchmarkNextToken(b *testing.B) {
+	src := generatedSource(1000)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		lexAll(NewPythonLexer(antlr.NewInputStream(src)))
+	}
+}
+
+func TestNoTabsMode(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want int // the number of the ErrTabInIndentation errors
+	}{
+		{"tab indentation", "if a:\n\tb\n", 1},
+		{"tab and spaces indentation", "if a:\n  \t  b\n", 1}, // reported besides the ErrMixedTabsSpaces
+		{"space indentation", "if a:\n    b\n", 0},
+		{"tab after the first token", "x =\t1\n", 0},
+		{"tab in a string", "if a:\n    b = 'c\td'\n", 0},
+		{"tab in a comment", "if a:\n    b  #\tc\n", 0},
+		{"tab on a blank line", "if a:\n    b\n\t\n    c\n", 0},
+		{"tab in a comment line", "if a:\n    b\n\t# c\n    d\n", 0},
+		{"tab in implicit line joining", "x = (1,\n\t2)\n", 0},
+		{"tab before the EOF", "x = 1\n\t", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			lexer.SetNoTabsMode(true)
+			tokens := lexAll(lexer)
+			got, errorTokens := 0, 0
+			for _, e := range lexer.Errors() {
+				if e.Code == ErrTabInIndentation {
+					got++
+				}
+			}
+			for _, token := range tokens {
+				if token.GetTokenType() == PythonLexerERRORTOKEN && strings.Contains(token.GetText(), "tabs are not allowed") {
+					errorTokens++
+				}
+			}
+			if got != tt.want || errorTokens != tt.want {
+				t.Errorf("got %d errors and %d ERRORTOKEN tokens, want %d: %v", got, errorTokens, tt.want, lexer.Errors())
+			}
+		})
+	}
+}
+
+// the memory of the pending token queue is bounded by the number of the waiting tokens, not the number of all tokens
+func BenchmarkLongInput(b *testing.B) {
+	src := generatedSource(50000)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(src)))
+	maxQueueCap := 0
+	for i := 0; i < b.N; i++ {
+		lexer := NewPythonLexer(antlr.NewInputStream(src))
+		for lexer.NextToken().GetTokenType() != antlr.TokenEOF {
+			maxQueueCap = max(maxQueueCap, cap(lexer.pendingTokens.tokens))
+		}
+	}
+	b.ReportMetric(float64(maxQueueCap), "max-queue-cap")
+}
+
+func TestConsumedTokensAreCollectable(t *testing.T) {
+	if testing.Short() {
+		t.Skip("lexes a long input")
+	}
+	lexer := NewPythonLexer(antlr.NewInputStream(generatedSource(4000)))
+	var tokens, collected atomic.Int64
+	for {
+		token := lexer.NextToken()
+		if token.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+		tokens.Add(1)
+		runtime.SetFinalizer(token, func(antlr.Token) { collected.Add(1) })
+	}
+	// only the last tokens may be referenced by the lexer
+	for deadline := time.Now().Add(5 * time.Second); collected.Load() < tokens.Load()-10 && time.Now().Before(deadline); {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got, want := collected.Load(), tokens.Load()-10; got < want {
+		t.Errorf("%d of %d consumed tokens are collected, want at least %d", got, tokens.Load(), want)
+	}
+	runtime.KeepAlive(lexer)
+}
+
+// dedentSource returns a Python source with n blocks nested to the depth, every block is closed by depth DEDENT tokens.
+func dedentSource(n int, depth int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		for d := 0; d < depth; d++ {
+			b.WriteString(strings.Repeat("    ", d) + "if a:\n")
+		}
+		b.WriteString(strings.Repeat("    ", depth) + "pass\n")
+	}
+	return b.String()
+}
+
+func BenchmarkTokenPooling(b *testing.B) {
+	src := dedentSource(200, 20) // 4000 INDENT and DEDENT tokens
+	for _, usePool := range []bool{false, true} {
+		b.Run("pooling="+strconv.FormatBool(usePool), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				lexer := NewPythonLexer(antlr.NewInputStream(src))
+				lexer.SetTokenPooling(usePool)
+				for token := lexer.NextToken(); token.GetTokenType() != antlr.TokenEOF; token = lexer.NextToken() {
+					ReleaseTokens([]antlr.Token{token}) // a streaming consumer gives back every token after its use
+				}
+			}
+		})
+	}
+}
+
+// runeIndentationLength is the rune by rune reference of the getIndentationLength (without the mixed tabs and spaces check)
+func runeIndentationLength(textWS string, tabLength int, formFeedMode FormFeedMode) int {
+	length := 0
+	for _, c := range textWS {
+		switch c {
+		case ' ':
+			length++
+		case '\t':
+			length += tabLength - (length % tabLength)
+		case '\f':
+			if formFeedMode == FormFeedReset {
+				length = 0
+			}
+		}
+	}
+	return length
+}
+
+func TestIndentationLengthByBytes(t *testing.T) {
+	files, err := filepath.Glob("../tests/*.py")
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := []string{"", " ", "\t", "  \t", "\t  ", "\f  ", "  \f\t", " \t \t", "\r", "    \r"}
+	for _, file := range append(files, "../example.py") {
+		input, err := antlr.NewFileStream(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, token := range lexAll(NewPythonLexer(input)) {
+			if token.GetTokenType() == PythonLexerWS {
+				texts = append(texts, token.GetText())
+			}
+		}
+	}
+	for _, formFeedMode := range []FormFeedMode{FormFeedReset, FormFeedIgnore, FormFeedError} {
+		for _, tabLength := range []int{1, 4, 8} {
+			for _, text := range texts {
+				lexer := newTestLexer("")
+				lexer.SetFormFeedMode(formFeedMode)
+				if err := lexer.SetTabLength(tabLength); err != nil {
+					t.Fatal(err)
+				}
+				lexer.wasIndentationMixedWithSpacesAndTabs = true // only the length is compared
+				if got, want := lexer.getIndentationLength(text), runeIndentationLength(text, tabLength, formFeedMode); got != want {
+					t.Errorf("%q (tab length %d, form feed mode %d): got %d, want %d", text, tabLength, formFeedMode, got, want)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkLongIndentation(b *testing.B) {
+	spaces := dedentSource(20, 40) // up to 160 spaces
+	for _, bm := range []struct{ name, src string }{{"spaces", spaces}, {"tabs", strings.ReplaceAll(spaces, "    ", "\t")}} {
+		src := bm.src
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(src)))
+			for i := 0; i < b.N; i++ {
+				lexAll(NewPythonLexer(antlr.NewInputStream(src)))
+			}
+		})
+	}
+}
+
+// tokenTexts returns the tokens in the DumpTokens like line:column TYPE(channel) 'text' format.
+func tokenTexts(lexer *PythonLexer, tokens []antlr.Token) []string {
+	texts := make([]string, len(tokens))
+	for i, token := range tokens {
+		texts[i] = strconv.Itoa(token.GetLine()) + ":" + strconv.Itoa(token.GetColumn()) + " " +
+			lexer.SymbolicName(token.GetTokenType()) + "(" + strconv.Itoa(token.GetChannel()) + ") " + strconv.Quote(token.GetText())
+	}
+	return texts
+}
+
+func TestSaveStateRoundTrip(t *testing.T) {
+	sources := []string{
+		"if a:\n    b = (1,\n         2)\n    # c\n\n    match x:\n        case 1:\n            pass\nd\n",
+		"x = f\"{a!r:>{width}} {b}\"\ny = f'''\n{c}'''\n",
+		"def f():\n\tif a:\n\t\treturn\n\n",
+	}
+	for _, src := range sources {
+		all := lexAll(newTestLexer(src))
+		for saved := 0; saved < len(all); saved++ {
+			lexer := newTestLexer(src)
+			lexer.SetSoftKeywordMatch(true)
+			var tokens []antlr.Token
+			for i := 0; i < saved; i++ {
+				tokens = append(tokens, lexer.NextToken())
+			}
+			state := lexer.SaveState()
+			want := tokenTexts(lexer, lexAll(lexer))
+
+			restored := newTestLexer(src)
+			restored.SetSoftKeywordMatch(true)
+			if err := restored.RestoreState(state); err != nil {
+				t.Fatalf("%q after %d tokens: %v", src, saved, err)
+			}
+			if got := tokenTexts(restored, lexAll(restored)); !slices.Equal(got, want) {
+				t.Errorf("%q after %d tokens:\ngot  %v\nwant %v", src, saved, got, want)
+			}
+		}
+	}
+}
+
+func TestRestoreStateEmptyIndentStack(t *testing.T) {
+	lexer := newTestLexer("if a:\n    b\n")
+	lexer.NextToken()
+	state := lexer.SaveState()
+	state.IndentLengthStack = nil
+	if err := newTestLexer("if a:\n    b\n").RestoreState(state); err == nil {
+		t.Errorf("RestoreState of a state with an empty indentation stack after the first token: no error")
+	}
+}
+
+func TestRecoverDedents(t *testing.T) {
+	const src = "if a:\n    if b:\n        c\n   d\n   e\nf\n" // 4-space steps and a 3-space dedent
+	tests := []struct {
+		name           string
+		recoverDedents bool
+		want           string
+		hooks          string
+	}{
+		{"recovered", true,
+			"IF NAME COLON NEWLINE INDENT IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT NAME NEWLINE NAME NEWLINE DEDENT NAME NEWLINE EOF",
+			"+1:4 +2:8 -1:4 -0:0 +1:3 -0:0"},
+		{"not recovered", false, // the lexer continues at the top level, so the next line is indented
+			"IF NAME COLON NEWLINE INDENT IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT ERRORTOKEN NAME NEWLINE INDENT NAME NEWLINE DEDENT NAME NEWLINE EOF",
+			"+1:4 +2:8 -1:4 -0:0 +1:3 -0:0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(src)
+			lexer.SetRecoverDedents(tt.recoverDedents)
+			var hooks []string
+			lexer.OnIndent = func(level, width int, _ antlr.Token) {
+				hooks = append(hooks, "+"+strconv.Itoa(level)+":"+strconv.Itoa(width))
+			}
+			lexer.OnDedent = func(level, width int, _ antlr.Token) {
+				hooks = append(hooks, "-"+strconv.Itoa(level)+":"+strconv.Itoa(width))
+			}
+			if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			if got := strings.Join(hooks, " "); got != tt.hooks {
+				t.Errorf("got hooks %s, want %s", got, tt.hooks)
+			}
+			if errors := lexer.Errors(); len(errors) != 1 || errors[0].Code != ErrInconsistentDedent || errors[0].Line != 4 {
+				t.Errorf("got errors %v, want one inconsistent dedent in line 4", errors)
+			}
+		})
+	}
+}
+
+func ExampleNewPythonTokenSource() {
+	source := NewPythonTokenSource(antlr.NewInputStream("x=1\n"))
+	lexer := source.(*PythonLexer)
+	for token := source.NextToken(); token.GetTokenType() != antlr.TokenEOF; token = source.NextToken() {
+		fmt.Println(lexer.SymbolicName(token.GetTokenType()), strconv.Quote(token.GetText()))
+	}
+	// Output:
+	// NAME "x"
+	// EQUAL "="
+	// NUMBER "1"
+	// NEWLINE "\n"
+}
+
+func ExampleNewPythonCommonTokenStream() {
+	stream := NewPythonCommonTokenStream(antlr.NewInputStream("x=1\n"))
+	stream.Fill()
+	lexer := stream.GetTokenSource().(*PythonLexer)
+	var names []string
+	for _, token := range stream.GetAllTokens() {
+		names = append(names, lexer.SymbolicName(token.GetTokenType()))
+	}
+	fmt.Println(strings.Join(names, " "))
+	// Output: NAME EQUAL NUMBER NEWLINE EOF
+}
+
+func TestSkipBOM(t *testing.T) {
+	const src = "import os\n"
+	tests := []struct {
+		name    string
+		src     string
+		skipBOM bool
+		want    string
+	}{
+		{"no BOM", src, true, "IMPORT NAME NEWLINE EOF"},
+		{"BOM skipped", "\uFEFF" + src, true, "IMPORT NAME NEWLINE EOF"},
+		{"BOM kept", "\uFEFF" + src, false, "ERRORTOKEN IMPORT NAME NEWLINE EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			lexer.SetSkipBOM(tt.skipBOM)
+			tokens := lexAll(lexer)
+			if got := defaultTypes(lexer, tokens); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			if tt.skipBOM && len(lexer.Errors()) != 0 {
+				t.Errorf("got errors %v, want none", lexer.Errors())
+			}
+		})
+	}
+}
+
+func TestFormFeedMode(t *testing.T) {
+	const src = "if x:\n    y\n  \f    z\n" // 4 columns after the form feed, 6 columns without resetting
+	tests := []struct {
+		name         string
+		src          string
+		formFeedMode FormFeedMode
+		want         string
+		errors       int
+	}{
+		{"reset", src, FormFeedReset, "IF NAME COLON NEWLINE INDENT NAME NEWLINE NAME NEWLINE DEDENT EOF", 0},
+		{"ignore", src, FormFeedIgnore, "IF NAME COLON NEWLINE INDENT NAME NEWLINE INDENT NAME NEWLINE DEDENT DEDENT EOF", 0},
+		{"error", src, FormFeedError, "IF NAME COLON NEWLINE INDENT NAME NEWLINE ERRORTOKEN INDENT NAME NEWLINE DEDENT DEDENT EOF", 1},
+		{"error before EOF", "x = 1\n  \f  ", FormFeedError, "NAME EQUAL NUMBER NEWLINE EOF", 0}, // the trailing whitespace is not an indentation
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			lexer.SetFormFeedMode(tt.formFeedMode)
+			if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			if errors := lexer.Errors(); len(errors) != tt.errors {
+				t.Errorf("got errors %v, want %d", errors, tt.errors)
+			} else if tt.errors > 0 && errors[0].Code != ErrFormFeedInIndent {
+				t.Errorf("got error code %s, want %s", errors[0].Code, ErrFormFeedInIndent)
+			}
+		})
+	}
+}
+
+func TestUnmatchedClosingBracket(t *testing.T) {
+	tests := []struct {
+		src    string
+		want   string
+		errors int
+	}{
+		{")\nx = 1\n", "RPAR NEWLINE NAME EQUAL NUMBER NEWLINE EOF", 1},
+		{")\nif x:\n    y\n", "RPAR NEWLINE IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT EOF", 1},
+		{"]\n}\nx\n", "RSQB NEWLINE RBRACE NEWLINE NAME NEWLINE EOF", 2},
+		{"(x))\ny\n", "LPAR NAME RPAR RPAR NEWLINE NAME NEWLINE EOF", 1},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer(tt.src)
+		if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want {
+			t.Errorf("%q:\ngot  %s\nwant %s", tt.src, got, tt.want)
+		}
+		errors := lexer.Errors()
+		if len(errors) != tt.errors {
+			t.Errorf("%q: got errors %v, want %d", tt.src, errors, tt.errors)
+		}
+		for _, e := range errors {
+			if e.Code != ErrUnmatchedBracket {
+				t.Errorf("%q: got error code %s, want %s", tt.src, e.Code, ErrUnmatchedBracket)
+			}
+		}
+		if depth := lexer.OpenBracketDepth(); depth != 0 {
+			t.Errorf("%q: OpenBracketDepth() = %d, want 0", tt.src, depth)
+		}
+	}
+}
+
+func TestInteractiveMode(t *testing.T) {
+	tests := []struct {
+		src            string
+		want           string
+		needsMoreInput bool
+	}{
+		{"if x:\n", "IF NAME COLON NEWLINE EOF", true},
+		{"if x:\n    y\n", "IF NAME COLON NEWLINE INDENT NAME NEWLINE EOF", true},
+		{"if x:\n    y\n\n", "IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT EOF", false}, // the blank line completes the block
+		{"f(\n", "NAME LPAR EOF", true},
+		{"x = (1,\n 2)\n", "NAME EQUAL LPAR NUMBER COMMA NUMBER RPAR NEWLINE EOF", false},
+		{"x = 1\n", "NAME EQUAL NUMBER NEWLINE EOF", false},
+		{"", "EOF", false},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer(tt.src)
+		lexer.SetInteractiveMode(true)
+		if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want {
+			t.Errorf("%q:\ngot  %s\nwant %s", tt.src, got, tt.want)
+		}
+		if got := lexer.NeedsMoreInput(); got != tt.needsMoreInput {
+			t.Errorf("%q: NeedsMoreInput() = %v, want %v", tt.src, got, tt.needsMoreInput)
+		}
+		if errors := lexer.Errors(); len(errors) != 0 {
+			t.Errorf("%q: got errors %v, want none", tt.src, errors)
+		}
+	}
+}
+
+func TestValidateNumericUnderscores(t *testing.T) {
+	tests := []struct {
+		src   string
+		want  string
+		valid bool
+	}{
+		{"1_000_000\n", "NUMBER NEWLINE EOF", true},
+		{"0x_FF\n", "NUMBER NEWLINE EOF", true},
+		{"0b_1\n", "NUMBER NEWLINE EOF", true},
+		{"1_0.2_5e1_0\n", "NUMBER NEWLINE EOF", true},
+		{"1_0j\n", "NUMBER NEWLINE EOF", true},
+		{"_1\n", "NAME NEWLINE EOF", true}, // an identifier
+		{"1__0\n", "NUMBER NAME NEWLINE EOF", false},
+		{"1_\n", "NUMBER NAME NEWLINE EOF", false},
+		{"0x__FF\n", "NUMBER NAME NEWLINE EOF", false},
+		{"0x_\n", "NUMBER NAME NEWLINE EOF", false},
+		{"1._5\n", "NUMBER NAME NEWLINE EOF", false},
+		{"1.5_\n", "NUMBER NAME NEWLINE EOF", false},
+		{"1e_5\n", "NUMBER NAME NEWLINE EOF", false},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer(tt.src)
+		lexer.SetValidateNumericUnderscores(true)
+		if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want {
+			t.Errorf("%q:\ngot  %s\nwant %s", tt.src, got, tt.want)
+		}
+		errors := lexer.Errors()
+		switch {
+		case tt.valid && len(errors) != 0:
+			t.Errorf("%q: got errors %v, want none", tt.src, errors)
+		case !tt.valid && (len(errors) != 1 || errors[0].Code != ErrNumericUnderscore):
+			t.Errorf("%q: got errors %v, want one %s", tt.src, errors, ErrNumericUnderscore)
+		}
+	}
+}
+
+func TestEmitTypeComments(t *testing.T) {
+	tests := []struct {
+		comment    string
+		emit       bool
+		wantType   int
+		expression string
+	}{
+		{"# type: int", true, PythonLexerTYPE_COMMENT, "int"},
+		{"# type: ignore", true, PythonLexerTYPE_COMMENT, "ignore"},
+		{"#type:List[int]", true, PythonLexerTYPE_COMMENT, "List[int]"},
+		{"# typeof", true, PythonLexerCOMMENT, ""},
+		{"# types: int", true, PythonLexerCOMMENT, ""},
+		{"# type: int", false, PythonLexerCOMMENT, ""},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer("x = f()  " + tt.comment + "\n")
+		lexer.SetEmitTypeComments(tt.emit)
+		var comment antlr.Token
+		for _, token := range lexAll(lexer) {
+			if token.GetText() == tt.comment {
+				comment = token
+			}
+		}
+		if comment == nil {
+			t.Errorf("%q: no token with the text of the comment", tt.comment)
+			continue
+		}
+		if comment.GetTokenType() != tt.wantType || comment.GetChannel() != antlr.TokenHiddenChannel {
+			t.Errorf("%q (emit: %v): got %s on channel %d, want %s on the hidden channel", tt.comment, tt.emit,
+				lexer.SymbolicName(comment.GetTokenType()), comment.GetChannel(), lexer.SymbolicName(tt.wantType))
+		}
+		expression, ok := TypeCommentExpression(comment)
+		if expression != tt.expression || ok != (tt.wantType == PythonLexerTYPE_COMMENT) {
+			t.Errorf("%q: TypeCommentExpression() = %q, %v, want %q", tt.comment, expression, ok, tt.expression)
+		}
+	}
+}
+
+func TestMaxIndentDepth(t *testing.T) {
+	tests := []struct {
+		src  string
+		want int
+	}{
+		{"", 0},
+		{"x\n", 0},
+		{"if a:\n if b:\n  if c:\n   if d:\n    if e:\n     f\n", 5},
+		{"if a:\n    if b:\n        c\nif d:\n    e\n", 2}, // the deepest level, not the last one
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer(tt.src)
+		lexAll(lexer)
+		if got := lexer.MaxIndentDepth(); got != tt.want {
+			t.Errorf("%q: MaxIndentDepth() = %d, want %d", tt.src, got, tt.want)
+		}
+		lexer.Reset()
+		if got := lexer.MaxIndentDepth(); got != 0 {
+			t.Errorf("%q: MaxIndentDepth() after Reset = %d, want 0", tt.src, got)
+		}
+	}
+}
+
+func TestRecordLineIndents(t *testing.T) {
+	const src = "def f(x):\n    # comment\n\n    if x:\n        return (1,\n  2)\n    return 0\ny\n"
+	lexer := newTestLexer(src)
+	lexer.SetRecordLineIndents(true)
+	lexAll(lexer)
+	want := map[int]int{1: 0, 4: 4, 5: 8, 7: 4, 8: 0} // without the comment, the blank and the continuation lines
+	if got := lexer.LineIndents(); !maps.Equal(got, want) {
+		t.Errorf("LineIndents() = %v, want %v", got, want)
+	}
+
+	lexer = newTestLexer(src)
+	lexAll(lexer)
+	if got := lexer.LineIndents(); len(got) != 0 {
+		t.Errorf("LineIndents() without SetRecordLineIndents = %v, want none", got)
+	}
+}
+
+func TestErrorRecovery(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           string
+		errorRecovery bool
+		want          string
+		code          LexerErrorCode
+	}{
+		{"mixed tabs", "if a:\n        b\n\t c\n        d\ne\n", false, // the tab and the space are 9 columns by the tab stops
+			"IF NAME COLON NEWLINE INDENT NAME NEWLINE ERRORTOKEN NAME NEWLINE NAME NEWLINE DEDENT NAME NEWLINE EOF", ErrMixedTabsSpaces},
+		{"mixed tabs recovered", "if a:\n        b\n\t c\n        d\ne\n", true,
+			"IF NAME COLON NEWLINE INDENT NAME NEWLINE INDENT NAME NEWLINE DEDENT NAME NEWLINE DEDENT NAME NEWLINE EOF", ErrMixedTabsSpaces},
+		{"inconsistent dedent", "if a:\n    b\n  c\n    d\ne\n", false,
+			"IF NAME COLON NEWLINE INDENT NAME NEWLINE ERRORTOKEN NAME NEWLINE INDENT NAME NEWLINE DEDENT NAME NEWLINE EOF", ErrInconsistentDedent},
+		{"inconsistent dedent recovered", "if a:\n    b\n  c\n    d\ne\n", true,
+			"IF NAME COLON NEWLINE INDENT NAME NEWLINE NAME NEWLINE INDENT NAME NEWLINE DEDENT DEDENT NAME NEWLINE EOF", ErrInconsistentDedent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			lexer.SetErrorRecovery(tt.errorRecovery)
+			if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			if errors := lexer.Errors(); len(errors) != 1 || errors[0].Code != tt.code || errors[0].Line != 3 {
+				t.Errorf("got errors %v, want one %s in line 3", errors, tt.code)
+			}
+		})
+	}
+}
+
+func TestDedentPositionMode(t *testing.T) {
+	tests := []struct {
+		src  string
+		mode DedentPositionMode
+		want string // line:column start-stop of the DEDENT tokens
+	}{
+		{"if a:\n    bb\nc\n", DedentAtFollowing, "3:0 13-12"},
+		{"if a:\n    bb\nc\n", DedentAtPreviousLineEnd, "2:6 12-11"},
+		{"if a:\n    if b:\n        cc  # x\n\nd\n", DedentAtFollowing, "5:0 33-32, 5:0 33-32"},
+		{"if a:\n    if b:\n        cc  # x\n\nd\n", DedentAtPreviousLineEnd, "3:10 26-25, 3:10 26-25"}, // after cc, not after the comment
+		{"if a:\n    bb\n", DedentAtFollowing, "3:0 13-12"},                                             // at the EOF
+		{"if a:\n    bb\n", DedentAtPreviousLineEnd, "2:6 12-11"},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer(tt.src)
+		lexer.SetDedentPositionMode(tt.mode)
+		var positions []string
+		for _, token := range lexAll(lexer) {
+			if token.GetTokenType() == PythonLexerDEDENT {
+				positions = append(positions, fmt.Sprintf("%d:%d %d-%d", token.GetLine(), token.GetColumn(), token.GetStart(), token.GetStop()))
+			}
+		}
+		if got := strings.Join(positions, ", "); got != tt.want {
+			t.Errorf("%q (mode %d): got DEDENT positions %s, want %s", tt.src, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestIndentationErrorListener(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{"mixed tabs", "if a:\n        b\n\t c\nd\n",
+			[]string{"line 3:0 inconsistent use of tabs and spaces in indentation\n\t c\n^"}},
+		{"warning and error", "if a:\n   b = 1)\n", []string{
+			"line 2:0 warning: indentation of 3 columns is not a multiple of 4\n   b = 1)\n^",
+			"line 2:8 unmatched closing bracket\n   b = 1)\n        ^"}},
+		{"tab before the caret", "if a:\n\tb = 1)\n",
+			[]string{"line 2:6 unmatched closing bracket\n\tb = 1)\n\t     ^"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			listener := NewIndentationErrorListener()
+			lexer.AddErrorListener(listener)
+			lexer.AddWarningListener(listener)
+			lexer.SetIndentUnit(4)
+			lexAll(lexer)
+			if got := listener.Formatted(); !slices.Equal(got, tt.want) {
+				t.Errorf("got  %q\nwant %q", got, tt.want)
+			}
+			for _, reportedError := range listener.Errors() {
+				if strings.Contains(reportedError.Message, "LEXER") || reportedError.Code == "" {
+					t.Errorf("got %+v, want a message without the prefix and a lexer error code", reportedError)
+				}
+			}
+		})
+	}
+}
+
+func TestAllowLeadingIndent(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		allow     bool
+		want      string
+		errorCode LexerErrorCode // empty for no error
+	}{
+		{"not allowed", "    x = 1\n    y\n", false,
+			"INDENT NAME EQUAL NUMBER NEWLINE INDENT NAME NEWLINE DEDENT EOF", ErrUnexpectedIndent},
+		{"allowed", "    x = 1\n    if y:\n        z\n    w\n", true,
+			"INDENT NAME EQUAL NUMBER NEWLINE IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT NAME NEWLINE DEDENT EOF", ""},
+		{"dedented then reindented", "    x\ny\n    z\n", true,
+			"INDENT NAME NEWLINE DEDENT NAME NEWLINE INDENT NAME NEWLINE DEDENT EOF", ""},
+		{"after a comment", "\n  # comment\n    x\n", true, "INDENT NAME NEWLINE DEDENT EOF", ""},
+		{"inconsistent dedent", "    x\n  y\n", true, "INDENT NAME NEWLINE ERRORTOKEN NAME NEWLINE EOF", ErrInconsistentDedent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			lexer.SetAllowLeadingIndent(tt.allow)
+			if got := defaultTypes(lexer, lexAll(lexer)); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			errors := lexer.Errors()
+			switch {
+			case tt.errorCode == "" && len(errors) != 0:
+				t.Errorf("got errors %v, want none", errors)
+			case tt.errorCode != "" && (len(errors) != 1 || errors[0].Code != tt.errorCode):
+				t.Errorf("got errors %v, want one %s", errors, tt.errorCode)
+			}
+		})
+	}
+}
+
+func TestEmitEndmarker(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"", "ENDMARKER EOF"},
+		{"# comment\n", "ENDMARKER EOF"},
+		{"x\n", "NAME NEWLINE ENDMARKER EOF"},
+		{"x", "NAME NEWLINE ENDMARKER EOF"},
+		{"if a:\n    if b:\n        c\n", "IF NAME COLON NEWLINE INDENT IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT DEDENT ENDMARKER EOF"},
+	}
+	for _, tt := range tests {
+		lexer := newTestLexer(tt.src)
+		lexer.SetEmitEndmarker(true)
+		tokens := lexAll(lexer)
+		if got := defaultTypes(lexer, tokens); got != tt.want {
+			t.Errorf("%q:\ngot  %s\nwant %s", tt.src, got, tt.want)
+		}
+		endmarker, eof := tokens[len(tokens)-2], tokens[len(tokens)-1]
+		if endmarker.GetLine() != eof.GetLine() || endmarker.GetColumn() != eof.GetColumn() ||
+			endmarker.GetStart() != eof.GetStart() || endmarker.GetStop() != endmarker.GetStart()-1 {
+			t.Errorf("%q: ENDMARKER at %d:%d %d-%d, want a zero-length token at the EOF %d:%d %d", tt.src,
+				endmarker.GetLine(), endmarker.GetColumn(), endmarker.GetStart(), endmarker.GetStop(),
+				eof.GetLine(), eof.GetColumn(), eof.GetStart())
+		}
+	}
+}
+
+func TestCoalesceDedents(t *testing.T) {
+	const src = "if a:\n if b:\n  if c:\n   d\n"
+	tests := []struct {
+		name     string
+		src      string
+		coalesce bool
+		want     string // the text and the DedentCount of the DEDENT tokens
+	}{
+		{"3 levels", src + "e\n", true, "<DEDENT x3>:3"},
+		{"2 levels", src + " e\n", true, "<DEDENT x2>:2, <DEDENT>:1"},
+		{"trailing", src, true, "<DEDENT x3>:3"},
+		{"not coalesced", src + "e\n", false, "<DEDENT>:1, <DEDENT>:1, <DEDENT>:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(tt.src)
+			lexer.SetCoalesceDedents(tt.coalesce)
+			var dedents []string
+			for _, token := range lexAll(lexer) {
+				if token.GetTokenType() == PythonLexerDEDENT {
+					dedents = append(dedents, token.GetText()+":"+strconv.Itoa(DedentCount(token)))
+				} else if count := DedentCount(token); count != 0 {
+					t.Errorf("DedentCount(%s) = %d, want 0", lexer.SymbolicName(token.GetTokenType()), count)
+				}
+			}
+			if got := strings.Join(dedents, ", "); got != tt.want {
+				t.Errorf("got DEDENT tokens %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// run by go test -race to detect the unsynchronized accesses
+func TestSynchronized(t *testing.T) {
+	src := generatedSource(200)
+	want := tokenTexts(newTestLexer(src), lexAll(newTestLexer(src)))
+
+	lexer := newTestLexer(src)
+	lexer.SetSynchronized(true)
+	var wg sync.WaitGroup
+	results := make([][]antlr.Token, 2)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				token := lexer.NextToken()
+				if token.GetTokenType() == antlr.TokenEOF {
+					return
+				}
+				results[i] = append(results[i], token)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// every token is returned once, the tokens of a goroutine are in the input order
+	for _, result := range results {
+		if !slices.IsSortedFunc(result, func(a, b antlr.Token) int { return a.GetStart() - b.GetStart() }) {
+			t.Errorf("the tokens of a goroutine are not in the input order")
+		}
+	}
+	got := append(tokenTexts(lexer, append(results[0], results[1]...)), want[len(want)-1]) // and the EOF token
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("got %d tokens, want the %d tokens of the sequential lexing", len(got), len(want))
+	}
+}
+
+func TestSymbolicName(t *testing.T) {
+	lexer := newTestLexer("")
+	tests := []struct {
+		ttype int
+		want  string
+	}{
+		{PythonLexerINDENT, "INDENT"},
+		{PythonLexerDEDENT, "DEDENT"},
+		{PythonLexerNEWLINE, "NEWLINE"},
+		{PythonLexerERRORTOKEN, "ERRORTOKEN"},
+		{PythonLexerENDMARKER, "ENDMARKER"},
+		{PythonLexerTYPE_COMMENT, "TYPE_COMMENT"},
+		{antlr.TokenEOF, "EOF"},
+		{0, ""}, // the invalid token type
+		{-2, ""},
+		{len(lexer.GetSymbolicNames()), ""},
+	}
+	for _, tt := range tests {
+		if got := lexer.SymbolicName(tt.ttype); got != tt.want {
+			t.Errorf("SymbolicName(%d) = %q, want %q", tt.ttype, got, tt.want)
+		}
+	}
+}
+
+func TestLineEndings(t *testing.T) {
+	const src = "if a:\n    bb\nc = 1)\n" // the line endings are replaced
+	tests := []struct {
+		name       string
+		lineEnding string
+		dedent     string // line:column of the DEDENT token at the previous line end
+		errorLine  string // the formatted unmatched bracket error
+	}{
+		{"LF", "\n", "2:6", "line 3:5 unmatched closing bracket\nc = 1)\n     ^"},
+		{"CRLF", "\r\n", "2:6", "line 3:5 unmatched closing bracket\nc = 1)\n     ^"},
+		// the lexer starts a new line only at \n, so the input is one line
+		{"CR", "\r", "1:12", "line 1:18 unmatched closing bracket\nif a:\r    bb\rc = 1)\n                  ^"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexer := newTestLexer(strings.ReplaceAll(src, "\n", tt.lineEnding))
+			lexer.SetDedentPositionMode(DedentAtPreviousLineEnd)
+			listener := NewIndentationErrorListener()
+			lexer.AddErrorListener(listener)
+			tokens := lexAll(lexer)
+			if got, want := defaultTypes(lexer, tokens), "IF NAME COLON NEWLINE INDENT NAME NEWLINE DEDENT NAME EQUAL NUMBER RPAR NEWLINE EOF"; got != want {
+				t.Errorf("got  %s\nwant %s", got, want)
+			}
+			for _, token := range tokens {
+				if got := strconv.Itoa(token.GetLine()) + ":" + strconv.Itoa(token.GetColumn()); token.GetTokenType() == PythonLexerDEDENT && got != tt.dedent {
+					t.Errorf("got DEDENT at %s, want %s", got, tt.dedent)
+				}
+			}
+			if got := listener.Formatted(); len(got) != 1 || got[0] != tt.errorLine {
+				t.Errorf("got  %q\nwant %q", got, tt.errorLine)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+//go:build ignore
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"gogrun4py/parser"
+)
+
+func main() {
+	input, err := antlr.NewFileStream(os.Args[1])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	tokens := parser.NewPythonCommonTokenStream(input)
+	pythonParser := parser.NewPythonParser(tokens)
+
+	tokens.Fill()
+	for _, token := range tokens.GetAllTokens() {
+		fmt.Println(getTokenMetaDataWithTokenName(pythonParser, token))
+	}
+
+	pythonParser.File_input()
+}
+
+func getTokenMetaDataWithTokenName(pythonParser *parser.PythonParser, token antlr.Token) string {
+	tokenText := strings.NewReplacer("\r", "\\r", "\n", "\\n").Replace(token.GetText())
+	tokenName := "EOF"
+	if token.GetTokenType() != antlr.TokenEOF {
+		tokenName = pythonParser.GetSymbolicNames()[token.GetTokenType()]
+	}
+	channelText := ""
+	if token.GetChannel() != antlr.TokenDefaultChannel {
+		channelText = fmt.Sprintf("channel=%d,", token.GetChannel())
+	}
+
+	//              [@tokenIndex,start:stop='tokenText',<tokenName>,channel=channel,line:column]
+	return fmt.Sprintf("[@%d,%d:%d='%s',<%s>,%s%d:%d]", token.GetTokenIndex(), token.GetStart(), token.GetStop(),
+		tokenText, tokenName, channelText, token.GetLine(), token.GetColumn())
+}
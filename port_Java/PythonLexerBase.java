@@ -228,7 +228,7 @@ public abstract class PythonLexerBase extends Lexer {
     }
 
     private void handleSTRINGtoken() { // remove the \<newline> escape sequences from the string literal
-        final String line_joinFreeStringLiteral = this.curToken.getText().replaceAll("\\\\\\r?\\n", "");
+        final String line_joinFreeStringLiteral = this.curToken.getText().replaceAll("\\\\(\\r?\\n|\\r)", "");
         if (this.curToken.getText().length() == line_joinFreeStringLiteral.length()) {
             this.addPendingToken(this.curToken);
         } else {
@@ -227,7 +227,7 @@ export default class PythonLexerBase extends Lexer {
     }
 
     handleSTRINGtoken() { // remove the \<newline> escape sequences from the string literal
-        const line_joinFreeStringLiteral = this.curToken.text.replace(/\\(\r?\n)/g, "");
+        const line_joinFreeStringLiteral = this.curToken.text.replace(/\\(\r?\n|\r)/g, "");
         if (this.curToken.text.length === line_joinFreeStringLiteral.length) {
             this.addPendingToken(this.curToken);
         } else {
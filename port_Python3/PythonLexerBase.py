@@ -187,7 +187,7 @@ class PythonLexerBase(Lexer):
 
     def handle_STRING_token(self): # remove the \<newline> escape sequences from the string literal
         # https://docs.python.org/3.11/reference/lexical_analysis.html#string-and-bytes-literals
-        line_joinFreeStringLiteral: str = re.sub(r"\\\r?\n", "", self.cur_token.text)
+        line_joinFreeStringLiteral: str = re.sub(r"\\(\r?\n|\r)", "", self.cur_token.text)
         if len(self.cur_token.text) == len(line_joinFreeStringLiteral):
             self.add_pending_token(self.cur_token)
         else:
@@ -0,0 +1,13 @@
+# COMMAND LINE:
+# grun Python file_input -tokens test_hidden_NEWLINE_before_whitespace_line.py
+#
+# EXPECTATIONS:
+#   - hidden NEWLINE token (channel=1) before the line of only spaces
+#   - no INDENT or DEDENT token for the line of only spaces
+#   - no error message
+if True:
+    i = 1
+    
+    j = 1
+        
+k = 1
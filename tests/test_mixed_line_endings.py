@@ -0,0 +1,12 @@
+# COMMAND LINE:
+# grun Python file_input -tokens test_mixed_line_endings.py
+#
+# EXPECTATIONS:
+#   - the CR LF (Windows) and the CR (old Macintosh) line endings are NEWLINE tokens like the LF
+#   - INDENT and DEDENT tokens as with LF line endings
+#   - no error message
+
+if True:
+    i = 1    if i:
+        j = 2
+k = 3
\ No newline at end of file
@@ -0,0 +1,11 @@
+# COMMAND LINE:
+# grun Python file_input -tokens test_walrus_operator.py
+#
+# EXPECTATIONS:
+#   - COLONEQUAL tokens (not COLON and EQUAL tokens), also inside brackets
+#   - INDENT and DEDENT tokens after the while statement
+#   - no error message
+
+y = [y := f(x), y**2]
+while (n := next()) :
+    print(n)